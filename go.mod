@@ -2,4 +2,4 @@ module github.com/cenieto/decimate
 
 go 1.23.5
 
-require gonum.org/v1/gonum v0.15.1 // indirect
+require gonum.org/v1/gonum v0.15.1
@@ -0,0 +1,106 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import "math"
+
+// sectionBounds returns the positions splitting a list of points into the sections simplified
+// independently: the first and last positions, the pinned vertices and the corners sharper than
+// the corner angle of the configuration.
+//
+// Parameters:
+//   - points ([][]float64): The list of points, already validated.
+//   - config (options): The configuration of the decimation algorithm.
+//
+// Returns:
+//   - []int: The positions of the bounds of the sections, in increasing order.
+//   - error: An error wrapping ErrPinnedOutOfRange if a pinned position is not in the list, or
+//     ErrNegativeThreshold if the corner angle or the maximum segment length is not valid.
+func (d Decimate) sectionBounds(points [][]float64, config options) ([]int, error) {
+	if err := validateThreshold("cornerAngle", config.cornerAngle); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("maxSegmentLength", config.maxSegmentLength); err != nil {
+		return nil, err
+	}
+
+	pinned := config.pinned
+	if !math.IsInf(config.cornerAngle, 1) {
+		pinned = append([]int(nil), config.pinned...)
+		for i := 1; i < len(points)-1; i++ {
+			if d.turningAngle(points[i-1], points[i], points[i+1]) > config.cornerAngle {
+				pinned = append(pinned, i)
+			}
+		}
+	}
+	return pinnedBounds(pinned, len(points))
+}
+
+// turningAngle computes the angle between the direction of the segment arriving at a vertex and
+// the direction of the segment leaving it. It is zero for a straight run and π for a vertex where
+// the polyline doubles back on itself. The angle is found from the lengths of the sides of the
+// triangle formed by the three vertices, measured by the geometry, so it follows the geodesic and
+// projected geometries, including across the antimeridian.
+//
+// Parameters:
+//   - previous ([]float64): The coordinates of the previous vertex.
+//   - point ([]float64): The coordinates of the vertex.
+//   - next ([]float64): The coordinates of the next vertex.
+//
+// Returns:
+//   - float64: The turning angle in radians, zero if one of the segments has no length.
+func (d Decimate) turningAngle(previous, point, next []float64) float64 {
+	incoming := d.pointDistance(previous, point)
+	outgoing := d.pointDistance(point, next)
+	if incoming == 0 || outgoing == 0 {
+		return 0
+	}
+	shortcut := d.pointDistance(previous, next)
+
+	// The law of cosines gives the interior angle at the vertex, the supplement of the turn.
+	cosine := (incoming*incoming + outgoing*outgoing - shortcut*shortcut) / (2 * incoming * outgoing)
+	return math.Pi - math.Acos(math.Max(-1, math.Min(1, cosine)))
+}
+
+// splitLongSegments adds vertices of the input to a simplified polyline until no segment is
+// longer than maxLength, or joins consecutive input points. Every added vertex is the farthest
+// one along the input that lies within maxLength of the previous kept vertex.
+//
+// Parameters:
+//   - points ([][]float64): The list of points that was simplified.
+//   - indices ([]int): The positions of the kept points, in increasing order.
+//   - maxLength (float64): The maximum length of a segment, infinite to leave the result as is.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) splitLongSegments(points [][]float64, indices []int, maxLength float64) []int {
+	if math.IsInf(maxLength, 1) {
+		return indices
+	}
+
+	result := []int{indices[0]}
+	for _, end := range indices[1:] {
+		key := result[len(result)-1]
+		for end-key > 1 && d.pointDistance(points[key], points[end]) > maxLength {
+			next := key + 1
+			for next+1 < end && d.pointDistance(points[key], points[next+1]) <= maxLength {
+				next++
+			}
+			result = append(result, next)
+			key = next
+		}
+		result = append(result, end)
+	}
+	return result
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"github.com/cenieto/decimate/pkg/interfaces"
+	"github.com/cenieto/decimate/pkg/primitives"
+)
+
+// primitiveGeometry adapts a Geometry that does not implement interfaces.CoordinateGeometry,
+// building the primitives required by the Geometry methods for every call.
+type primitiveGeometry struct {
+	interfaces.Geometry
+}
+
+// DoubleAreaTriangleCoordinates calculates the double of the area of the triangle formed by a point
+// and the line going from start to end.
+func (g primitiveGeometry) DoubleAreaTriangleCoordinates(point, start, end []float64) float64 {
+	line := primitives.NewLine(primitives.NewPoint(start), primitives.NewPoint(end))
+	return g.DoubleAreaTriangle(primitives.NewPoint(point), line)
+}
+
+// DistancePointLineCoordinates computes the shortest distance from a point to the line going from
+// start to end.
+func (g primitiveGeometry) DistancePointLineCoordinates(point, start, end []float64) float64 {
+	line := primitives.NewLine(primitives.NewPoint(start), primitives.NewPoint(end))
+	return g.DistancePointLine(primitives.NewPoint(point), line)
+}
+
+// DistancePointSegmentCoordinates computes the shortest distance from a point to the segment going
+// from start to end.
+func (g primitiveGeometry) DistancePointSegmentCoordinates(point, start, end []float64) float64 {
+	line := primitives.NewLine(primitives.NewPoint(start), primitives.NewPoint(end))
+	return g.DistancePointSegment(primitives.NewPoint(point), line)
+}
+
+// coordinates returns the geometry of the decimate operation as an interfaces.CoordinateGeometry,
+// so that algorithms can work on coordinate slices whether or not the geometry supports it natively.
+//
+// Returns:
+//   - interfaces.CoordinateGeometry: The geometry operating on coordinate slices.
+func (d Decimate) coordinates() interfaces.CoordinateGeometry {
+	if geometry, ok := d.Geometry.(interfaces.CoordinateGeometry); ok {
+		return geometry
+	}
+	return primitiveGeometry{Geometry: d.Geometry}
+}
+
+// pointDistance computes the distance between two points, as the distance from the second one to
+// the segment of zero length made of the first one.
+//
+// Parameters:
+//   - a ([]float64): The coordinates of the first point.
+//   - b ([]float64): The coordinates of the second point.
+//
+// Returns:
+//   - float64: The distance between the points.
+func (d Decimate) pointDistance(a, b []float64) float64 {
+	return d.coordinates().DistancePointSegmentCoordinates(b, a, a)
+}
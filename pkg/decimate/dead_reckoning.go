@@ -0,0 +1,100 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+// DeadReckoningStream compresses a trajectory as its points arrive by dead reckoning: the position
+// of the object is predicted from the speed and heading it had at the last emitted point, and a
+// point is only emitted when the prediction misses it by more than a tolerance. It holds two
+// points in memory and is built by NewDeadReckoningStream.
+type DeadReckoningStream struct {
+	trajectoryStream
+	tolerance float64   // Largest distance between a point and its predicted position
+	anchor    []float64 // Last emitted point, nil before the first push
+	origin    []float64 // Point received before the anchor, giving its velocity, nil if still
+	previous  []float64 // Last pushed point
+	pending   bool      // Whether the last pushed point is not emitted
+}
+
+// NewDeadReckoningStream builds a stream compressing a trajectory by dead reckoning. The velocity
+// at an emitted point is the one of the segment arriving at it, or zero when the point received
+// before it has the same timestamp or there is none, and the predicted position at a
+// later timestamp is measured with the synchronized Euclidean distance of the geometry, which must
+// implement interfaces.SynchronizedGeometry.
+//
+// Parameters:
+//   - tolerance (float64): The largest distance between a point and its predicted position.
+//
+// Returns:
+//   - *DeadReckoningStream: The stream, which has not received any point.
+//   - error: An error wrapping ErrNegativeThreshold if tolerance is not valid, or
+//     ErrUnsupportedGeometry if the geometry has no timestamps.
+func (d Decimate) NewDeadReckoningStream(tolerance float64) (*DeadReckoningStream, error) {
+	if err := validateThreshold("tolerance", tolerance); err != nil {
+		return nil, err
+	}
+	stream, err := newTrajectoryStream(d)
+	if err != nil {
+		return nil, err
+	}
+	return &DeadReckoningStream{trajectoryStream: stream, tolerance: tolerance}, nil
+}
+
+// Push gives the next point of the trajectory to the stream.
+//
+// Parameters:
+//   - point ([]float64): The point, whose timestamp is given by the geometry.
+//
+// Returns:
+//   - [][]float64: The emitted points, either none or the given point.
+//   - error: An error wrapping ErrNotMonotonic if the timestamp of the point is before the one of
+//     the previous point, or another sentinel error if the point is not valid.
+func (s *DeadReckoningStream) Push(point []float64) ([][]float64, error) {
+	if err := s.accept(point); err != nil {
+		return nil, err
+	}
+
+	previous := s.previous
+	s.previous = point
+	if s.anchor != nil {
+		// The origin gives the velocity at the anchor, and an anchor without origin is still.
+		origin := s.anchor
+		if s.origin != nil {
+			origin = s.origin
+		}
+		if s.synchronized.SynchronizedDistanceCoordinates(point, origin, s.anchor) <= s.tolerance {
+			s.pending = true
+			return nil, nil
+		}
+	}
+
+	// A previous point with the timestamp of the anchor gives no velocity, so the anchor is still.
+	s.anchor, s.origin, s.pending = point, nil, false
+	if previous != nil && s.synchronized.Time(previous) != s.synchronized.Time(point) {
+		s.origin = previous
+	}
+	return [][]float64{point}, nil
+}
+
+// Flush emits the last point received by the stream, if it is not emitted yet, so the end of the
+// trajectory is kept. The stream can keep receiving points afterwards.
+//
+// Returns:
+//   - [][]float64: The emitted points.
+func (s *DeadReckoningStream) Flush() [][]float64 {
+	if !s.pending {
+		return nil
+	}
+	s.anchor, s.origin, s.pending = s.previous, nil, false
+	return [][]float64{s.previous}
+}
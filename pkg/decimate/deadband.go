@@ -0,0 +1,172 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import "math"
+
+// DeadbandStream compresses a time series with an exception deadband as its points arrive,
+// holding a single point in memory. It is built by NewDeadbandStream.
+type DeadbandStream struct {
+	historian
+	deadband float64 // Largest change of value that is not reported
+}
+
+// NewDeadbandStream builds a stream compressing a time series with an exception deadband, see
+// DeadbandIndicesE. Points are given with Push, which returns the points archived as soon as they
+// are known, and Flush archives the last point of the series.
+//
+// Parameters:
+//   - deadband (float64): The largest change of value from the last archived point that is not
+//     reported.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - *DeadbandStream: The stream, which has not received any point.
+//   - error: An error wrapping ErrNegativeThreshold if deadband or the maximum interval are not
+//     valid, or ErrUnsupportedDimension if the points of the geometry have no value.
+func (d Decimate) NewDeadbandStream(deadband float64, opts ...Option) (*DeadbandStream, error) {
+	if err := validateThreshold("deadband", deadband); err != nil {
+		return nil, err
+	}
+	h, err := newHistorian(d, newOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return &DeadbandStream{historian: h, deadband: deadband}, nil
+}
+
+// Push gives the next point of the time series to the stream.
+//
+// Parameters:
+//   - point ([]float64): The point, with the time as first coordinate and the value as second one.
+//
+// Returns:
+//   - [][]float64: The points archived after receiving the point, in time order.
+//   - error: An error wrapping ErrNotMonotonic if the time of the point is before the time of the
+//     previous point, or another sentinel error if the point is not valid.
+func (s *DeadbandStream) Push(point []float64) ([][]float64, error) {
+	return s.push(point, s.step)
+}
+
+// Flush archives the last point received by the stream, if it is not archived yet. The stream
+// can keep receiving points afterwards.
+//
+// Returns:
+//   - [][]float64: The archived points.
+func (s *DeadbandStream) Flush() [][]float64 {
+	s.flush()
+	_, points := s.drain()
+	return points
+}
+
+// step runs the exception deadband over the point being pushed.
+//
+// Parameters:
+//   - point ([]float64): The point being pushed.
+func (s *DeadbandStream) step(point []float64) {
+	if s.archived == nil {
+		s.archive(s.count, point)
+		return
+	}
+	s.expire(point)
+
+	if math.Abs(point[1]-s.archived[1]) > s.deadband {
+		if s.held != nil {
+			s.archive(s.heldIndex, s.held)
+		}
+		s.archive(s.count, point)
+		return
+	}
+	s.hold(point)
+}
+
+// Deadband compresses a time series using an exception deadband.
+// It panics if the input is not valid, see DeadbandE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - deadband (float64): The largest change of value that is not reported.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - [][]float64: The archived points.
+func (d Decimate) Deadband(points [][]float64, deadband float64, opts ...Option) [][]float64 {
+	return mustPoints(d.DeadbandE(points, deadband, opts...))
+}
+
+// DeadbandE compresses a time series using an exception deadband.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - deadband (float64): The largest change of value that is not reported.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - [][]float64: The archived points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) DeadbandE(points [][]float64, deadband float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.DeadbandIndicesE(points, deadband, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// DeadbandIndices compresses a time series using an exception deadband and returns the positions
+// of the archived points in the input list. It panics if the input is not valid, see
+// DeadbandIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - deadband (float64): The largest change of value that is not reported.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - []int: The positions of the archived points, in increasing order.
+func (d Decimate) DeadbandIndices(points [][]float64, deadband float64, opts ...Option) []int {
+	return mustIndices(d.DeadbandIndicesE(points, deadband, opts...))
+}
+
+// DeadbandIndicesE compresses a time series using an exception deadband and returns the positions
+// of the archived points in the input list.
+// A point is archived when its value differs from the value of the last archived point by more
+// than deadband. The point before it is then archived too, so that a step is not drawn as a slow
+// ramp between both archived points. The last point is always archived, and WithMaxInterval
+// archives the previous point whenever a point arrives later than the given interval after the
+// last archived one. The result is the one of a DeadbandStream given every point and flushed.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - deadband (float64): The largest change of value from the last archived point that is not
+//     reported.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - []int: The positions of the archived points, in increasing order.
+//   - error: An error wrapping ErrNotMonotonic if the time decreases, or another sentinel error if
+//     the input is not valid.
+func (d Decimate) DeadbandIndicesE(points [][]float64, deadband float64, opts ...Option) ([]int, error) {
+	if err := d.validateSeries(points); err != nil {
+		return nil, err
+	}
+	stream, err := d.NewDeadbandStream(deadband, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return stream.run(points, stream.step), nil
+}
@@ -14,10 +14,9 @@
 package decimate
 
 import (
-	"errors"
 	"fmt"
 	"github.com/cenieto/decimate/pkg/interfaces"
-	"github.com/cenieto/decimate/pkg/primitives"
+	"math"
 )
 
 // Decimate is a struct that represents a decimate operation.
@@ -39,87 +38,241 @@ func NewDecimate(geometry interfaces.Geometry) *Decimate {
 }
 
 // ValidateInputPointList validates the input point list.
+// Every point must have the dimension of the geometry and finite coordinates.
 //
 // Parameters:
 //   - points ([][]float64): The list of points to be validated.
 //
 // Returns:
-//   - error: An error if the input point list is not valid.
+//   - error: An error wrapping ErrDimensionMismatch or ErrNonFiniteCoordinate if the input point list is not valid.
 //   - nil: If the input point list is valid.
 func (d Decimate) ValidateInputPointList(points [][]float64) error {
 
-	errorMsg := ""
-	// size_points := len(points)
-	// if size_points < 2 {
-	// 	errorMsg += fmt.Sprintf("Length of point list must be greater than one and is %v, %v", size_points, points)
-	// 	return errors.New(errorMsg)
-	// }
-	// return nil
-
-	if len(points) > 1 {
-		for i, point := range points {
-			if len(point) != d.Geometry.Dimension() {
-				errorMsg += fmt.Sprintf("All points must have the same dimension as the geometry. Point at position %v has dimension %v, but the geometry has dimension %v", i, len(point), d.Geometry.Dimension())
+	for i, point := range points {
+		if len(point) != d.Geometry.Dimension() {
+			return fmt.Errorf("%w: all points must have the same dimension as the geometry. Point at position %v has dimension %v, but the geometry has dimension %v", ErrDimensionMismatch, i, len(point), d.Geometry.Dimension())
+		}
+		for j, coordinate := range point {
+			if math.IsNaN(coordinate) || math.IsInf(coordinate, 0) {
+				return fmt.Errorf("%w: coordinate %v of point at position %v is %v", ErrNonFiniteCoordinate, j, i, coordinate)
 			}
 		}
 	}
 
-	if errorMsg != "" {
-		return errors.New(errorMsg)
-	}
-
 	return nil
 
 }
 
 // DouglasPeucker is a function that simplifies a list of points using the Douglas-Peucker algorithm.
+// It panics if the input is not valid, see DouglasPeuckerE for the error-returning form.
 //
 // Parameters:
 //   - points ([][]float64): The list of points to be simplified.
 //   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
 //
 // Returns:
 //   - [][]float64: The simplified list of points.
-func (d Decimate) DouglasPeucker(points [][]float64, threshold float64) [][]float64 {
+func (d Decimate) DouglasPeucker(points [][]float64, threshold float64, opts ...Option) [][]float64 {
+	return mustPoints(d.DouglasPeuckerE(points, threshold, opts...))
+}
+
+// DouglasPeuckerE simplifies a list of points using the Douglas-Peucker algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+//   - error: An error wrapping ErrTooFewPoints, ErrDimensionMismatch, ErrNonFiniteCoordinate
+//     or ErrNegativeThreshold if the input is not valid.
+func (d Decimate) DouglasPeuckerE(points [][]float64, threshold float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.DouglasPeuckerIndicesE(points, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// DouglasPeuckerIndices simplifies a list of points using the Douglas-Peucker algorithm and
+// returns the positions of the kept points in the input list. It panics if the input is not
+// valid, see DouglasPeuckerIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) DouglasPeuckerIndices(points [][]float64, threshold float64, opts ...Option) []int {
+	return mustIndices(d.DouglasPeuckerIndicesE(points, threshold, opts...))
+}
 
-	errorMsg := d.ValidateInputPointList(points)
+// DouglasPeuckerIndicesE simplifies a list of points using the Douglas-Peucker algorithm and
+// returns the positions of the kept points in the input list.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrTooFewPoints, ErrDimensionMismatch, ErrNonFiniteCoordinate
+//     or ErrNegativeThreshold if the input is not valid.
+func (d Decimate) DouglasPeuckerIndicesE(points [][]float64, threshold float64, opts ...Option) ([]int, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("threshold", threshold); err != nil {
+		return nil, err
+	}
 
-	if errorMsg != nil {
-		panic(errorMsg)
+	config := newOptions(opts)
+	if err := validateDistance(config.distance); err != nil {
+		return nil, err
+	}
+	reduced, positions, bounds, err := d.prefilter(points, config)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(points) == 2 {
-		return points
+	keep := make([]bool, len(reduced))
+	for _, bound := range bounds {
+		keep[bound] = true
+	}
+	for k := 1; k < len(bounds); k++ {
+		d.douglasPeucker(reduced, bounds[k-1], bounds[k], threshold, config, keep)
+	}
+	return d.splitLongSegments(points, restoreIndices(keptIndices(keep), positions), config.maxSegmentLength), nil
+}
+
+// farthestPoint finds the point between the positions first and last, both excluded, that
+// deviates the most from the line joining the points at those positions. When measuring the
+// distance to the infinite line, the point is found with the double area of the triangle it forms
+// with the line, which has the same maximum and is cheaper to compute.
+//
+// When the points at first and last coincide, as in closed rings, the line is degenerate and the
+// distance to that single point is used instead, so the section is split at its farthest vertex.
+//
+// Parameters:
+//   - points ([][]float64): The list of points.
+//   - first (int): Position of the first point of the line.
+//   - last (int): Position of the last point of the line.
+//   - config (options): The configuration of the algorithm, which selects how the deviation of a
+//     point is measured.
+//
+// Returns:
+//   - int: The position of the farthest point, or -1 if no point deviates from the line.
+//   - float64: The distance from the farthest point to the line.
+func (d Decimate) farthestPoint(points [][]float64, first, last int, config options) (int, float64) {
+
+	geometry := d.coordinates()
+	start, end := points[first], points[last]
+
+	mode := config.distance
+	if mode == LineDistance && equalCoordinates(start, end) {
+		mode = SegmentDistance
 	}
 
 	var distance float64
-	distance_maximum := 0.0
-
-	size_points := len(points)
-	point_1 := primitives.NewPoint(points[0])
-	point_2 := primitives.NewPoint(points[size_points-1])
-	line := primitives.NewLine(point_1, point_2)
-
-	var index int
-	for i := range points {
-		if i != 0 && i != size_points-1 {
-			point_0 := primitives.NewPoint(points[i])
-			distance = d.Geometry.DoubleAreaTriangle(point_0, line)
-			if distance > distance_maximum {
-				distance_maximum = distance
-				index = i
-			}
+	distanceMaximum := 0.0
+
+	index := -1
+	for i := first + 1; i < last; i++ {
+		switch {
+		case config.synchronized != nil:
+			distance = config.synchronized.SynchronizedDistanceCoordinates(points[i], start, end)
+		case mode == SegmentDistance:
+			distance = geometry.DistancePointSegmentCoordinates(points[i], start, end)
+		default:
+			distance = geometry.DoubleAreaTriangleCoordinates(points[i], start, end)
+		}
+		if distance > distanceMaximum {
+			distanceMaximum = distance
+			index = i
 		}
 	}
 
-	point_0 := primitives.NewPoint(points[index])
-	distance_maximum = d.Geometry.DistancePointLine(point_0, line)
+	if index == -1 {
+		return index, 0.0
+	}
+	if mode != LineDistance || config.synchronized != nil {
+		return index, distanceMaximum
+	}
+	return index, geometry.DistancePointLineCoordinates(points[index], start, end)
+}
 
-	if distance_maximum < threshold {
-		return [][]float64{points[0], points[size_points-1]}
+// douglasPeucker runs the Douglas-Peucker algorithm on the points between the positions first
+// and last, marking in keep the points to be kept. Sections waiting to be split are stored in
+// an explicit stack instead of the call stack, so the memory used is bounded by the number of
+// points whatever the shape of the polyline. The input is expected to be already validated.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - first (int): Position of the first point of the section to be simplified.
+//   - last (int): Position of the last point of the section to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - config (options): The configuration of the algorithm.
+//   - keep ([]bool): The kept points, by position.
+func (d Decimate) douglasPeucker(points [][]float64, first, last int, threshold float64, config options, keep []bool) {
+
+	stack := []section{{first: first, last: last}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		index, distanceMaximum := d.farthestPoint(points, current.first, current.last, config)
+		if index == -1 || distanceMaximum < threshold {
+			continue
+		}
+
+		keep[index] = true
+		stack = append(stack, section{first: index, last: current.last}, section{first: current.first, last: index})
 	}
-	left := d.DouglasPeucker(points[:index+1], threshold)
-	right := d.DouglasPeucker(points[index:], threshold)
-	return append(left[:len(left)-1], right...)
 
 }
+
+// equalCoordinates reports whether two points have the same coordinates.
+//
+// Parameters:
+//   - a ([]float64): The coordinates of the first point.
+//   - b ([]float64): The coordinates of the second point.
+//
+// Returns:
+//   - bool: True if every coordinate is equal, false otherwise.
+func equalCoordinates(a, b []float64) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// keptIndices returns the positions marked in keep.
+//
+// Parameters:
+//   - keep ([]bool): The kept points, by position.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func keptIndices(keep []bool) []int {
+	count := 0
+	for _, kept := range keep {
+		if kept {
+			count++
+		}
+	}
+
+	indices := make([]int, 0, count)
+	for i, kept := range keep {
+		if kept {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+)
+
+// section is a part of the polyline, between two kept points, waiting to be split.
+type section struct {
+	first    int     // Position of the first point of the section
+	last     int     // Position of the last point of the section
+	index    int     // Position of the farthest point of the section
+	distance float64 // Distance from the farthest point to the line joining first and last
+}
+
+// sectionHeap is a max-heap of sections ordered by the distance of their farthest point.
+// Ties are broken by the position of the section in the input so results are deterministic.
+type sectionHeap []section
+
+func (h sectionHeap) Len() int { return len(h) }
+
+func (h sectionHeap) Less(i, j int) bool {
+	if h[i].distance == h[j].distance {
+		return h[i].first < h[j].first
+	}
+	return h[i].distance > h[j].distance
+}
+
+func (h sectionHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *sectionHeap) Push(x any) { *h = append(*h, x.(section)) }
+
+func (h *sectionHeap) Pop() any {
+	old := *h
+	size := len(old)
+	item := old[size-1]
+	*h = old[:size-1]
+	return item
+}
+
+// pushSection queues the section between first and last if it has a point deviating from the line.
+//
+// Parameters:
+//   - queue (*sectionHeap): The queue of sections waiting to be split.
+//   - points ([][]float64): The list of points being simplified.
+//   - first (int): Position of the first point of the section.
+//   - last (int): Position of the last point of the section.
+//   - config (options): The configuration of the algorithm.
+func (d Decimate) pushSection(queue *sectionHeap, points [][]float64, first, last int, config options) {
+	index, distance := d.farthestPoint(points, first, last, config)
+	if index != -1 {
+		heap.Push(queue, section{first: first, last: last, index: index, distance: distance})
+	}
+}
+
+// DouglasPeuckerN simplifies a list of points keeping at most maxPoints of them.
+// It panics if the input is not valid, see DouglasPeuckerNE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - maxPoints (int): The maximum number of points to be kept.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func (d Decimate) DouglasPeuckerN(points [][]float64, maxPoints int, opts ...Option) [][]float64 {
+	return mustPoints(d.DouglasPeuckerNE(points, maxPoints, opts...))
+}
+
+// DouglasPeuckerNE simplifies a list of points keeping at most maxPoints of them.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - maxPoints (int): The maximum number of points to be kept.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) DouglasPeuckerNE(points [][]float64, maxPoints int, opts ...Option) ([][]float64, error) {
+	indices, err := d.DouglasPeuckerNIndicesE(points, maxPoints, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// DouglasPeuckerNIndices simplifies a list of points keeping at most maxPoints of them and
+// returns the positions of the kept points in the input list. It panics if the input is not
+// valid, see DouglasPeuckerNIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - maxPoints (int): The maximum number of points to be kept.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) DouglasPeuckerNIndices(points [][]float64, maxPoints int, opts ...Option) []int {
+	return mustIndices(d.DouglasPeuckerNIndicesE(points, maxPoints, opts...))
+}
+
+// DouglasPeuckerNIndicesE simplifies a list of points keeping at most maxPoints of them and
+// returns the positions of the kept points in the input list.
+// Starting from the two end points, the section whose farthest point deviates the most from
+// its line is split at that point, until maxPoints points are kept or every remaining point
+// lies on the simplified line. The result is made of the maxPoints most significant points.
+// Vertices pinned with WithPinned and corners forced with WithCornerAngle split the initial
+// section and are always kept, even when there are more of them than maxPoints.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - maxPoints (int): The maximum number of points to be kept.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrInvalidPointBudget if maxPoints is lower than two, or
+//     another sentinel error if the input is not valid.
+func (d Decimate) DouglasPeuckerNIndicesE(points [][]float64, maxPoints int, opts ...Option) ([]int, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if maxPoints < 2 {
+		return nil, fmt.Errorf("%w: maxPoints must be at least 2, but it is %v", ErrInvalidPointBudget, maxPoints)
+	}
+
+	config := newOptions(opts)
+	if err := validateDistance(config.distance); err != nil {
+		return nil, err
+	}
+	reduced, positions, bounds, err := d.prefilter(points, config)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := append([]int(nil), bounds...)
+
+	queue := &sectionHeap{}
+	for k := 1; k < len(bounds); k++ {
+		d.pushSection(queue, reduced, bounds[k-1], bounds[k], config)
+	}
+	for queue.Len() > 0 && len(indices) < maxPoints {
+		split := heap.Pop(queue).(section)
+		indices = append(indices, split.index)
+		d.pushSection(queue, reduced, split.first, split.index, config)
+		d.pushSection(queue, reduced, split.index, split.last, config)
+	}
+
+	sort.Ints(indices)
+	return d.splitLongSegments(points, restoreIndices(indices, positions), config.maxSegmentLength), nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Sentinel errors returned by the error-returning decimation methods.
+// Returned errors wrap one of these values with details about the offending input,
+// so callers must compare them using errors.Is.
+var (
+	// ErrDimensionMismatch is returned when a point does not have the dimension of the geometry.
+	ErrDimensionMismatch = errors.New("decimate: dimension mismatch")
+	// ErrTooFewPoints is returned when the input does not contain enough points to be decimated.
+	ErrTooFewPoints = errors.New("decimate: too few points")
+	// ErrNonFiniteCoordinate is returned when a point has a NaN or infinite coordinate.
+	ErrNonFiniteCoordinate = errors.New("decimate: non-finite coordinate")
+	// ErrNegativeThreshold is returned when a threshold is negative or NaN.
+	ErrNegativeThreshold = errors.New("decimate: negative threshold")
+	// ErrInvalidPointBudget is returned when the number of points to keep is too small.
+	ErrInvalidPointBudget = errors.New("decimate: invalid point budget")
+	// ErrInvalidWindow is returned when the number of points in a search window is too small.
+	ErrInvalidWindow = errors.New("decimate: invalid window")
+	// ErrInvalidRepeat is returned when the number of passes over the points is too small.
+	ErrInvalidRepeat = errors.New("decimate: invalid repeat")
+	// ErrInputTooLarge is returned when the input has too many points for the cost of an algorithm.
+	ErrInputTooLarge = errors.New("decimate: input too large")
+	// ErrUnsupportedDimension is returned when an algorithm does not support the dimension of the points.
+	ErrUnsupportedDimension = errors.New("decimate: unsupported dimension")
+	// ErrInvalidRing is returned when a ring of a polygon is not closed or cannot enclose an area.
+	ErrInvalidRing = errors.New("decimate: invalid ring")
+	// ErrPinnedOutOfRange is returned when a pinned vertex is not a position of the input.
+	ErrPinnedOutOfRange = errors.New("decimate: pinned vertex out of range")
+	// ErrNotMonotonic is returned when the first coordinate of a time series decreases.
+	ErrNotMonotonic = errors.New("decimate: not monotonic")
+	// ErrUnsupportedGeometry is returned when an algorithm does not support the geometry.
+	ErrUnsupportedGeometry = errors.New("decimate: unsupported geometry")
+	// ErrInvalidParameter is returned when a parameter or an option has a value outside its domain.
+	ErrInvalidParameter = errors.New("decimate: invalid parameter")
+)
+
+// validateThreshold checks that a threshold can be used by a decimation algorithm.
+//
+// Parameters:
+//   - name (string): The name of the parameter, used in the error message.
+//   - threshold (float64): The threshold to be validated.
+//
+// Returns:
+//   - error: An error wrapping ErrNegativeThreshold if the threshold is negative or NaN.
+//   - nil: If the threshold is valid.
+func validateThreshold(name string, threshold float64) error {
+	if threshold < 0 || math.IsNaN(threshold) {
+		return fmt.Errorf("%w: %v must be a non-negative number, but it is %v", ErrNegativeThreshold, name, threshold)
+	}
+	return nil
+}
+
+// validateDistance checks that a distance mode is one of the values defined by DistanceMode.
+//
+// Parameters:
+//   - mode (DistanceMode): The distance mode to be validated.
+//
+// Returns:
+//   - error: An error wrapping ErrInvalidParameter if the distance mode is unknown.
+//   - nil: If the distance mode is valid.
+func validateDistance(mode DistanceMode) error {
+	if mode != LineDistance && mode != SegmentDistance {
+		return fmt.Errorf("%w: unknown distance mode %v", ErrInvalidParameter, mode)
+	}
+	return nil
+}
+
+// validateCollectionOptions checks that the options given to a collection or polygon algorithm
+// only include the ones it supports. Pinned vertices refer to the positions of a single polyline,
+// so they have no meaning for a collection of lines or rings, and corners are pinned vertices too.
+//
+// Parameters:
+//   - config (options): The options to be validated.
+//
+// Returns:
+//   - error: An error wrapping ErrInvalidParameter if pinned vertices or a corner angle are given.
+//   - nil: If the options are supported.
+func validateCollectionOptions(config options) error {
+	if len(config.pinned) > 0 {
+		return fmt.Errorf("%w: WithPinned is not supported by the collection and polygon algorithms", ErrInvalidParameter)
+	}
+	if !math.IsInf(config.cornerAngle, 1) {
+		return fmt.Errorf("%w: WithCornerAngle is not supported by the collection and polygon algorithms", ErrInvalidParameter)
+	}
+	return nil
+}
+
+// validateInput checks the input point list of a decimation algorithm.
+// Besides the checks done by ValidateInputPointList, it verifies that the list has at least
+// two points.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be validated.
+//
+// Returns:
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+//   - nil: If the input is valid.
+func (d Decimate) validateInput(points [][]float64) error {
+	if len(points) < 2 {
+		return fmt.Errorf("%w: length of point list must be greater than one and is %v", ErrTooFewPoints, len(points))
+	}
+	return d.ValidateInputPointList(points)
+}
+
+// validateLines checks the input line collection of a decimation algorithm.
+// It verifies that the collection is not empty and that every line is a valid input.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines to be validated.
+//
+// Returns:
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+//   - nil: If the input is valid.
+func (d Decimate) validateLines(lines [][][]float64) error {
+	if len(lines) == 0 {
+		return fmt.Errorf("%w: the collection of lines is empty", ErrTooFewPoints)
+	}
+	for l, points := range lines {
+		if err := d.validateInput(points); err != nil {
+			return fmt.Errorf("line %v: %w", l, err)
+		}
+	}
+	return nil
+}
+
+// validateSeries checks the input time series of a decimation algorithm.
+// Besides the checks done by validateInput, it verifies that every point has a value after its
+// first coordinate and that the first coordinate never decreases.
+//
+// Parameters:
+//   - points ([][]float64): The time series to be validated.
+//
+// Returns:
+//   - error: An error wrapping ErrUnsupportedDimension if the points have a single coordinate,
+//     ErrNotMonotonic if the first coordinate decreases, or another sentinel error if the input
+//     is not valid.
+//   - nil: If the input is valid.
+func (d Decimate) validateSeries(points [][]float64) error {
+	if err := d.validateInput(points); err != nil {
+		return err
+	}
+	if len(points[0]) < 2 {
+		return fmt.Errorf("%w: a time series needs points with a value, but they have dimension %v", ErrUnsupportedDimension, len(points[0]))
+	}
+	for i := 1; i < len(points); i++ {
+		if points[i][0] < points[i-1][0] {
+			return fmt.Errorf("%w: first coordinate decreases from %v to %v at position %v", ErrNotMonotonic, points[i-1][0], points[i][0], i)
+		}
+	}
+	return nil
+}
+
+// mustPoints panics if err is not nil, otherwise it returns the given points.
+// It is used to build the panicking forms of the decimation methods.
+func mustPoints(points [][]float64, err error) [][]float64 {
+	if err != nil {
+		panic(err)
+	}
+	return points
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"fmt"
+	"math"
+)
+
+// historian holds the state shared by the compressors of process historians, which receive the
+// points of a time series one at a time and archive some of them as soon as they can.
+type historian struct {
+	maxInterval float64                 // Longest time between archived points while points keep arriving
+	validate    func([][]float64) error // Validation of the coordinates of a pushed point
+	count       int                     // Number of points pushed
+	last        []float64               // Last pushed point, nil before the first push
+	archived    []float64               // Last archived point, nil before the first push
+	held        []float64               // Last pushed point when it is not archived, nil otherwise
+	heldIndex   int                     // Position of the held point in the series
+	indices     []int                   // Positions of the points archived since the last drain
+	points      [][]float64             // Points archived since the last drain
+}
+
+// newHistorian builds the shared state of a compressor of process historians.
+//
+// Parameters:
+//   - d (Decimate): The decimate operation whose geometry validates the pushed points.
+//   - config (options): The configuration of the compressor.
+//
+// Returns:
+//   - historian: The state of a compressor that has not received any point.
+//   - error: An error wrapping ErrUnsupportedDimension if the points of the geometry have no value
+//     after the time, or ErrNegativeThreshold if the maximum interval is not valid.
+func newHistorian(d Decimate, config options) (historian, error) {
+	if d.Geometry.Dimension() < 2 {
+		return historian{}, fmt.Errorf("%w: a time series needs points with a value, but the geometry has dimension %v", ErrUnsupportedDimension, d.Geometry.Dimension())
+	}
+	if err := validateThreshold("maxInterval", config.maxInterval); err != nil {
+		return historian{}, err
+	}
+	return historian{maxInterval: config.maxInterval, validate: d.ValidateInputPointList}, nil
+}
+
+// push validates a point, runs a step of the compressor over it and returns the archived points.
+//
+// Parameters:
+//   - point ([]float64): The point, with the time as first coordinate and the value as second one.
+//   - step (func([]float64)): The step of the compressor.
+//
+// Returns:
+//   - [][]float64: The points archived by the step, in time order.
+//   - error: An error wrapping ErrNotMonotonic if the time of the point is before the time of the
+//     previous point, or another sentinel error if the point is not valid.
+func (h *historian) push(point []float64, step func(point []float64)) ([][]float64, error) {
+	if err := h.validate([][]float64{point}); err != nil {
+		return nil, fmt.Errorf("point %v: %w", h.count, err)
+	}
+	if h.last != nil && point[0] < h.last[0] {
+		return nil, fmt.Errorf("%w: time decreases from %v to %v at point %v", ErrNotMonotonic, h.last[0], point[0], h.count)
+	}
+
+	step(point)
+	h.last = point
+	h.count++
+	_, points := h.drain()
+	return points, nil
+}
+
+// run runs a compressor over a validated time series and flushes it.
+//
+// Parameters:
+//   - points ([][]float64): The time series.
+//   - step (func([]float64)): The step of the compressor.
+//
+// Returns:
+//   - []int: The positions of the archived points, in increasing order.
+func (h *historian) run(points [][]float64, step func(point []float64)) []int {
+	var indices []int
+	for _, point := range points {
+		step(point)
+		h.last = point
+		h.count++
+		archived, _ := h.drain()
+		indices = append(indices, archived...)
+	}
+	h.flush()
+	archived, _ := h.drain()
+	return append(indices, archived...)
+}
+
+// flush archives the held point, if any.
+func (h *historian) flush() {
+	if h.held != nil {
+		h.archive(h.heldIndex, h.held)
+	}
+}
+
+// archive records a point as archived.
+//
+// Parameters:
+//   - index (int): The position of the point in the series.
+//   - point ([]float64): The point.
+func (h *historian) archive(index int, point []float64) {
+	h.archived = point
+	h.held = nil
+	h.indices = append(h.indices, index)
+	h.points = append(h.points, point)
+}
+
+// hold records the point being pushed as the last point not archived.
+//
+// Parameters:
+//   - point ([]float64): The point being pushed.
+func (h *historian) hold(point []float64) {
+	h.held = point
+	h.heldIndex = h.count
+}
+
+// expire archives the held point when the point being pushed is farther in time from the last
+// archived point than the maximum interval.
+//
+// Parameters:
+//   - point ([]float64): The point being pushed.
+//
+// Returns:
+//   - bool: True if the held point was archived.
+func (h *historian) expire(point []float64) bool {
+	if h.held == nil || point[0]-h.archived[0] <= h.maxInterval {
+		return false
+	}
+	h.archive(h.heldIndex, h.held)
+	return true
+}
+
+// drain returns the points archived since the last drain and forgets them.
+//
+// Returns:
+//   - []int: The positions of the archived points in the series.
+//   - [][]float64: The archived points.
+func (h *historian) drain() ([]int, [][]float64) {
+	indices, points := h.indices, h.points
+	h.indices, h.points = nil, nil
+	return indices, points
+}
+
+// slope returns the slope of the line going from a point to another one, infinite with the sign
+// of the change of value if both have the same time.
+//
+// Parameters:
+//   - from ([]float64): The first point, with the time as first coordinate.
+//   - time (float64): The time of the second point.
+//   - value (float64): The value of the second point.
+//
+// Returns:
+//   - float64: The change of value per unit of time.
+func slope(from []float64, time, value float64) float64 {
+	if time == from[0] {
+		if value > from[1] {
+			return math.Inf(1)
+		}
+		return math.Inf(-1)
+	}
+	return (value - from[1]) / (time - from[0])
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import "fmt"
+
+// Lang simplifies a list of points using the Lang algorithm.
+// It panics if the input is not valid, see LangE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The maximum distance from a removed point to the simplified line.
+//   - lookAhead (int): The number of points after the current key point in the search region.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func (d Decimate) Lang(points [][]float64, tolerance float64, lookAhead int, opts ...Option) [][]float64 {
+	return mustPoints(d.LangE(points, tolerance, lookAhead, opts...))
+}
+
+// LangE simplifies a list of points using the Lang algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The maximum distance from a removed point to the simplified line.
+//   - lookAhead (int): The number of points after the current key point in the search region.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) LangE(points [][]float64, tolerance float64, lookAhead int, opts ...Option) ([][]float64, error) {
+	indices, err := d.LangIndicesE(points, tolerance, lookAhead, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// LangIndices simplifies a list of points using the Lang algorithm and returns the positions of
+// the kept points in the input list. It panics if the input is not valid, see LangIndicesE for the
+// error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The maximum distance from a removed point to the simplified line.
+//   - lookAhead (int): The number of points after the current key point in the search region.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) LangIndices(points [][]float64, tolerance float64, lookAhead int, opts ...Option) []int {
+	return mustIndices(d.LangIndicesE(points, tolerance, lookAhead, opts...))
+}
+
+// LangIndicesE simplifies a list of points using the Lang algorithm and returns the positions of
+// the kept points in the input list.
+// The search region goes from the current key point to the point lookAhead positions after it.
+// While a point inside the region lies farther than tolerance from the line joining its ends, the
+// region is shrunk by one point. The end of the region then becomes the next key point. The work
+// done per kept point is bounded by the square of lookAhead, whatever the length of the input.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The maximum distance from a removed point to the simplified line.
+//   - lookAhead (int): The number of points after the current key point in the search region.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid, or
+//     ErrInvalidWindow if lookAhead is smaller than one.
+func (d Decimate) LangIndicesE(points [][]float64, tolerance float64, lookAhead int, opts ...Option) ([]int, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("tolerance", tolerance); err != nil {
+		return nil, err
+	}
+	if lookAhead < 1 {
+		return nil, fmt.Errorf("%w: lookAhead must be at least 1, but it is %v", ErrInvalidWindow, lookAhead)
+	}
+
+	config := newOptions(opts)
+	return d.splitAtPinned(points, config, func(section [][]float64) []int {
+		return d.lang(section, tolerance, lookAhead)
+	})
+}
+
+// lang runs the Lang algorithm over a section of the points between pinned vertices.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The maximum distance from a removed point to the simplified line.
+//   - lookAhead (int): The number of points after the current key point in the search region.
+//
+// Returns:
+//   - []int: The positions of the kept points in the section, in increasing order.
+func (d Decimate) lang(points [][]float64, tolerance float64, lookAhead int) []int {
+	last := len(points) - 1
+
+	indices := []int{0}
+	for key := 0; key < last; {
+		end := min(key+lookAhead, last)
+		for end > key+1 && !d.withinTolerance(points, key, end, tolerance) {
+			end--
+		}
+		indices = append(indices, end)
+		key = end
+	}
+
+	return indices
+}
+
+// withinTolerance checks whether every point strictly between first and last lies within
+// tolerance of the line joining them.
+//
+// Parameters:
+//   - points ([][]float64): The list of points.
+//   - first (int): The position of the start of the line.
+//   - last (int): The position of the end of the line.
+//   - tolerance (float64): The maximum distance from a point to the line.
+//
+// Returns:
+//   - bool: True if no point deviates more than tolerance from the line.
+func (d Decimate) withinTolerance(points [][]float64, first, last int, tolerance float64) bool {
+	geometry := d.coordinates()
+	for i := first + 1; i < last; i++ {
+		if geometry.DistancePointLineCoordinates(points[i], points[first], points[last]) > tolerance {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,320 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"fmt"
+	"math"
+)
+
+// LTTB downsamples a time series using the Largest-Triangle-Three-Buckets algorithm.
+// It panics if the input is not valid, see LTTBE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - threshold (int): The number of points to be kept.
+//
+// Returns:
+//   - [][]float64: The downsampled time series.
+func (d Decimate) LTTB(points [][]float64, threshold int) [][]float64 {
+	return mustPoints(d.LTTBE(points, threshold))
+}
+
+// LTTBE downsamples a time series using the Largest-Triangle-Three-Buckets algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - threshold (int): The number of points to be kept.
+//
+// Returns:
+//   - [][]float64: The downsampled time series.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) LTTBE(points [][]float64, threshold int) ([][]float64, error) {
+	indices, err := d.LTTBIndicesE(points, threshold)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// LTTBIndices downsamples a time series using the Largest-Triangle-Three-Buckets algorithm and
+// returns the positions of the kept points in the input list. It panics if the input is not
+// valid, see LTTBIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - threshold (int): The number of points to be kept.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) LTTBIndices(points [][]float64, threshold int) []int {
+	return mustIndices(d.LTTBIndicesE(points, threshold))
+}
+
+// LTTBIndicesE downsamples a time series using the Largest-Triangle-Three-Buckets algorithm and
+// returns the positions of the kept points in the input list.
+// The points between the first and the last ones are split into threshold-2 buckets of the same
+// number of points, and a single point is kept in every bucket: the one forming the largest
+// triangle with the point kept in the previous bucket and the average of the next bucket. The
+// result always has threshold points, or every point if there are fewer, which suits plotting
+// a series at a fixed resolution.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - threshold (int): The number of points to be kept.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrInvalidPointBudget if threshold is lower than two,
+//     ErrNotMonotonic if the time decreases, or another sentinel error if the input is not valid.
+func (d Decimate) LTTBIndicesE(points [][]float64, threshold int) ([]int, error) {
+	if err := d.validateSeries(points); err != nil {
+		return nil, err
+	}
+	if threshold < 2 {
+		return nil, fmt.Errorf("%w: threshold must be at least 2, but it is %v", ErrInvalidPointBudget, threshold)
+	}
+	return d.lttb(points, threshold), nil
+}
+
+// lttb runs the Largest-Triangle-Three-Buckets algorithm over a validated time series.
+//
+// Parameters:
+//   - points ([][]float64): The time series.
+//   - threshold (int): The number of points to be kept, at least two.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) lttb(points [][]float64, threshold int) []int {
+	size := len(points)
+	if threshold >= size {
+		return allIndices(size)
+	}
+
+	geometry := d.coordinates()
+	every := float64(size-2) / float64(threshold-2)
+	bucketStart := func(bucket int) int {
+		return int(math.Floor(float64(bucket)*every)) + 1
+	}
+
+	indices := make([]int, 0, threshold)
+	indices = append(indices, 0)
+	average := make([]float64, len(points[0]))
+	key := 0
+	for bucket := 0; bucket < threshold-2; bucket++ {
+		first, last := bucketStart(bucket+1), min(bucketStart(bucket+2), size)
+		for k := range average {
+			sum := 0.0
+			for _, point := range points[first:last] {
+				sum += point[k]
+			}
+			average[k] = sum / float64(last-first)
+		}
+
+		kept, largest := -1, -1.0
+		for i := bucketStart(bucket); i < first; i++ {
+			if area := geometry.DoubleAreaTriangleCoordinates(points[i], points[key], average); area > largest {
+				kept, largest = i, area
+			}
+		}
+		indices = append(indices, kept)
+		key = kept
+	}
+
+	return append(indices, size-1)
+}
+
+// MinMaxLTTB downsamples a time series using the MinMaxLTTB algorithm.
+// It panics if the input is not valid, see MinMaxLTTBE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - threshold (int): The number of points to be kept.
+//   - ratio (int): The number of points preselected for every kept point.
+//
+// Returns:
+//   - [][]float64: The downsampled time series.
+func (d Decimate) MinMaxLTTB(points [][]float64, threshold, ratio int) [][]float64 {
+	return mustPoints(d.MinMaxLTTBE(points, threshold, ratio))
+}
+
+// MinMaxLTTBE downsamples a time series using the MinMaxLTTB algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - threshold (int): The number of points to be kept.
+//   - ratio (int): The number of points preselected for every kept point.
+//
+// Returns:
+//   - [][]float64: The downsampled time series.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) MinMaxLTTBE(points [][]float64, threshold, ratio int) ([][]float64, error) {
+	indices, err := d.MinMaxLTTBIndicesE(points, threshold, ratio)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// MinMaxLTTBIndices downsamples a time series using the MinMaxLTTB algorithm and returns the
+// positions of the kept points in the input list. It panics if the input is not valid, see
+// MinMaxLTTBIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - threshold (int): The number of points to be kept.
+//   - ratio (int): The number of points preselected for every kept point.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) MinMaxLTTBIndices(points [][]float64, threshold, ratio int) []int {
+	return mustIndices(d.MinMaxLTTBIndicesE(points, threshold, ratio))
+}
+
+// MinMaxLTTBIndicesE downsamples a time series using the MinMaxLTTB algorithm and returns the
+// positions of the kept points in the input list.
+// The time range is split into threshold·ratio/2 columns of the same width, and the points with
+// the smallest and largest values of every column are preselected along with the first and last
+// points. LTTB then keeps threshold of the preselected points. The preselection is linear and
+// only keeps extreme values, so the result looks like the one of LTTB on long series at a
+// fraction of the cost. A ratio of 4 is a good default.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - threshold (int): The number of points to be kept.
+//   - ratio (int): The number of points preselected for every kept point.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrInvalidPointBudget if threshold is lower than two,
+//     ErrInvalidParameter if ratio is lower than one, ErrNotMonotonic if the time decreases, or
+//     another sentinel error if the input is not valid.
+func (d Decimate) MinMaxLTTBIndicesE(points [][]float64, threshold, ratio int) ([]int, error) {
+	if err := d.validateSeries(points); err != nil {
+		return nil, err
+	}
+	if threshold < 2 {
+		return nil, fmt.Errorf("%w: threshold must be at least 2, but it is %v", ErrInvalidPointBudget, threshold)
+	}
+	if ratio < 1 {
+		return nil, fmt.Errorf("%w: ratio must be at least 1, but it is %v", ErrInvalidParameter, ratio)
+	}
+
+	if threshold >= len(points) {
+		return allIndices(len(points)), nil
+	}
+
+	preselected := minMaxIndices(points, max(threshold*ratio/2, 1))
+	if len(preselected) <= threshold {
+		return preselected, nil
+	}
+	return restoreIndices(d.lttb(SelectPoints(points, preselected), threshold), preselected), nil
+}
+
+// minMaxIndices preselects the first and last points of a time series, and the points with the
+// smallest and largest values of every column of time.
+//
+// Parameters:
+//   - points ([][]float64): The time series.
+//   - columns (int): The number of columns of the same width splitting the time range.
+//
+// Returns:
+//   - []int: The positions of the preselected points, in increasing order.
+func minMaxIndices(points [][]float64, columns int) []int {
+	lowest := make([]int, columns)
+	highest := make([]int, columns)
+	for c := range lowest {
+		lowest[c], highest[c] = -1, -1
+	}
+
+	axis := newTimeColumns(points, columns)
+	for i, point := range points {
+		c := axis.column(point[0])
+		if lowest[c] < 0 || point[1] < points[lowest[c]][1] {
+			lowest[c] = i
+		}
+		if highest[c] < 0 || point[1] > points[highest[c]][1] {
+			highest[c] = i
+		}
+	}
+
+	keep := make([]bool, len(points))
+	keep[0] = true
+	keep[len(points)-1] = true
+	for c := range lowest {
+		if lowest[c] >= 0 {
+			keep[lowest[c]] = true
+			keep[highest[c]] = true
+		}
+	}
+	return keptIndices(keep)
+}
+
+// timeColumns splits the time range of a series into columns of the same width, as the pixel
+// columns of a chart.
+type timeColumns struct {
+	start   float64 // Time of the first point of the series
+	width   float64 // Time spanned by every column, zero if the series has a single time
+	columns int     // Number of columns
+}
+
+// newTimeColumns splits the time range of a series into columns of the same width.
+//
+// Parameters:
+//   - points ([][]float64): The time series, sorted by time.
+//   - columns (int): The number of columns.
+//
+// Returns:
+//   - timeColumns: The columns of the series.
+func newTimeColumns(points [][]float64, columns int) timeColumns {
+	start := points[0][0]
+	return timeColumns{start: start, width: (points[len(points)-1][0] - start) / float64(columns), columns: columns}
+}
+
+// column returns the column of a time of the series. The last time belongs to the last column.
+//
+// Parameters:
+//   - time (float64): The time, within the time range of the series.
+//
+// Returns:
+//   - int: The column of the time.
+func (t timeColumns) column(time float64) int {
+	if t.width == 0 {
+		return 0
+	}
+	return min(int((time-t.start)/t.width), t.columns-1)
+}
+
+// allIndices returns every position of a list of points.
+//
+// Parameters:
+//   - size (int): The number of points of the list.
+//
+// Returns:
+//   - []int: The positions from zero to size-1.
+func allIndices(size int) []int {
+	indices := make([]int, size)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import "fmt"
+
+// M4 downsamples a time series using the M4 aggregation.
+// It panics if the input is not valid, see M4E for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - columns (int): The number of pixel columns of the chart.
+//
+// Returns:
+//   - [][]float64: The downsampled time series.
+func (d Decimate) M4(points [][]float64, columns int) [][]float64 {
+	return mustPoints(d.M4E(points, columns))
+}
+
+// M4E downsamples a time series using the M4 aggregation.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - columns (int): The number of pixel columns of the chart.
+//
+// Returns:
+//   - [][]float64: The downsampled time series.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) M4E(points [][]float64, columns int) ([][]float64, error) {
+	indices, err := d.M4IndicesE(points, columns)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// M4Indices downsamples a time series using the M4 aggregation and returns the positions of the
+// kept points in the input list. It panics if the input is not valid, see M4IndicesE for the
+// error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - columns (int): The number of pixel columns of the chart.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) M4Indices(points [][]float64, columns int) []int {
+	return mustIndices(d.M4IndicesE(points, columns))
+}
+
+// M4IndicesE downsamples a time series using the M4 aggregation and returns the positions of the
+// kept points in the input list.
+// The time range is split into columns of the same width, and the first and last points of every
+// column are kept along with the points with its smallest and largest values. A line chart drawn
+// with those columns as pixel columns is identical to the one drawn with every point, so the
+// result has at most four points per column whatever the length of the series.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - columns (int): The number of pixel columns of the chart.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrInvalidPointBudget if columns is lower than one,
+//     ErrNotMonotonic if the time decreases, or another sentinel error if the input is not valid.
+func (d Decimate) M4IndicesE(points [][]float64, columns int) ([]int, error) {
+	if err := d.validateSeries(points); err != nil {
+		return nil, err
+	}
+	if columns < 1 {
+		return nil, fmt.Errorf("%w: columns must be at least 1, but it is %v", ErrInvalidPointBudget, columns)
+	}
+
+	// Every column holds the positions of its first, last, lowest and highest points.
+	aggregates := make([][4]int, columns)
+	for c := range aggregates {
+		aggregates[c] = [4]int{-1, -1, -1, -1}
+	}
+
+	axis := newTimeColumns(points, columns)
+	for i, point := range points {
+		aggregate := &aggregates[axis.column(point[0])]
+		if aggregate[0] < 0 {
+			*aggregate = [4]int{i, i, i, i}
+			continue
+		}
+		aggregate[1] = i
+		if point[1] < points[aggregate[2]][1] {
+			aggregate[2] = i
+		}
+		if point[1] > points[aggregate[3]][1] {
+			aggregate[3] = i
+		}
+	}
+
+	keep := make([]bool, len(points))
+	for _, aggregate := range aggregates {
+		if aggregate[0] >= 0 {
+			for _, index := range aggregate {
+				keep[index] = true
+			}
+		}
+	}
+	return keptIndices(keep), nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import "fmt"
+
+// Opheim simplifies a list of points using the Opheim algorithm.
+// It panics if the input is not valid, see OpheimE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minTol (float64): The half width of the strip around the current ray.
+//   - maxTol (float64): The maximum distance from the current key point to the points it replaces,
+//     not smaller than minTol.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func (d Decimate) Opheim(points [][]float64, minTol, maxTol float64, opts ...Option) [][]float64 {
+	return mustPoints(d.OpheimE(points, minTol, maxTol, opts...))
+}
+
+// OpheimE simplifies a list of points using the Opheim algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minTol (float64): The half width of the strip around the current ray.
+//   - maxTol (float64): The maximum distance from the current key point to the points it replaces,
+//     not smaller than minTol.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+//   - error: An error wrapping ErrInvalidParameter if maxTol is smaller than minTol, or another
+//     sentinel error if the input is not valid.
+func (d Decimate) OpheimE(points [][]float64, minTol, maxTol float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.OpheimIndicesE(points, minTol, maxTol, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// OpheimIndices simplifies a list of points using the Opheim algorithm and returns the positions
+// of the kept points in the input list. It panics if the input is not valid, see OpheimIndicesE
+// for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minTol (float64): The half width of the strip around the current ray.
+//   - maxTol (float64): The maximum distance from the current key point to the points it replaces,
+//     not smaller than minTol.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) OpheimIndices(points [][]float64, minTol, maxTol float64, opts ...Option) []int {
+	return mustIndices(d.OpheimIndicesE(points, minTol, maxTol, opts...))
+}
+
+// OpheimIndicesE simplifies a list of points using the Opheim algorithm and returns the positions
+// of the kept points in the input list.
+// It is a variant of Reumann-Witkam with a bounded search region. As in Opheim's definition, the
+// ray leaving the current key point goes through the first of the following points lying farther
+// than minTol from it, or through the last point if there is none, so its direction is not set by
+// the noise close to the key point. Points are then visited in order from the ray point until one
+// lies farther than minTol from the ray, or farther than maxTol from the key point, and the point
+// before it becomes the new key point. Every point is visited a bounded number of times, so the
+// algorithm runs in linear time.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minTol (float64): The half width of the strip around the current ray.
+//   - maxTol (float64): The maximum distance from the current key point to the points it replaces,
+//     not smaller than minTol.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrInvalidParameter if maxTol is smaller than minTol, or another
+//     sentinel error if the input is not valid.
+func (d Decimate) OpheimIndicesE(points [][]float64, minTol, maxTol float64, opts ...Option) ([]int, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("minTol", minTol); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("maxTol", maxTol); err != nil {
+		return nil, err
+	}
+	if maxTol < minTol {
+		return nil, fmt.Errorf("%w: maxTol must be at least minTol, %v, but it is %v", ErrInvalidParameter, minTol, maxTol)
+	}
+
+	config := newOptions(opts)
+	return d.splitAtPinned(points, config, func(section [][]float64) []int {
+		return d.opheim(section, minTol, maxTol)
+	})
+}
+
+// opheim runs the Opheim algorithm over a section of the points between pinned vertices.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minTol (float64): The half width of the strip around the current ray.
+//   - maxTol (float64): The maximum distance from the current key point to the points it replaces.
+//
+// Returns:
+//   - []int: The positions of the kept points in the section, in increasing order.
+func (d Decimate) opheim(points [][]float64, minTol, maxTol float64) []int {
+	geometry := d.coordinates()
+	last := len(points) - 1
+
+	indices := []int{0}
+	key := 0
+	for {
+		ray := key + 1
+		for ray < last && d.pointDistance(points[key], points[ray]) <= minTol {
+			ray++
+		}
+
+		next := ray
+		for ; next <= last; next++ {
+			if geometry.DistancePointLineCoordinates(points[next], points[key], points[ray]) > minTol ||
+				d.pointDistance(points[key], points[next]) > maxTol {
+				break
+			}
+		}
+
+		if next > last {
+			break
+		}
+		// A ray point farther than maxTol from the key point becomes the key point itself.
+		key = max(next-1, key+1)
+		if key == last {
+			break
+		}
+		indices = append(indices, key)
+	}
+
+	return append(indices, last)
+}
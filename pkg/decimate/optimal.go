@@ -0,0 +1,171 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"fmt"
+	"math"
+)
+
+// OptimalMaxPoints is the largest number of points that Optimal simplifies without WithWindow.
+// The shortcut graph of n points has O(n²) edges, each checked in O(n), so larger inputs must
+// bound the length of the shortcuts or be reduced with WithPrefilter first.
+const OptimalMaxPoints = 500
+
+// OptimalMaxCost is the largest cost of the shortcuts that Optimal builds, measured as n·w² for n
+// points and shortcuts spanning up to w of them. It is the cost of OptimalMaxPoints points
+// without a window, so a window only allows larger inputs when it makes the search cheaper.
+const OptimalMaxCost = OptimalMaxPoints * (OptimalMaxPoints - 1) * (OptimalMaxPoints - 1)
+
+// Optimal simplifies a list of points keeping the smallest number of them such that every removed
+// point lies within epsilon of the simplified line. It panics if the input is not valid, see
+// OptimalE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - epsilon (float64): The maximum distance from a removed point to the simplified line.
+//   - opts (...Option): Options of the algorithm, such as WithDistance or WithWindow.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func (d Decimate) Optimal(points [][]float64, epsilon float64, opts ...Option) [][]float64 {
+	return mustPoints(d.OptimalE(points, epsilon, opts...))
+}
+
+// OptimalE simplifies a list of points keeping the smallest number of them such that every
+// removed point lies within epsilon of the simplified line.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - epsilon (float64): The maximum distance from a removed point to the simplified line.
+//   - opts (...Option): Options of the algorithm, such as WithDistance or WithWindow.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) OptimalE(points [][]float64, epsilon float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.OptimalIndicesE(points, epsilon, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// OptimalIndices simplifies a list of points keeping the smallest number of them such that every
+// removed point lies within epsilon of the simplified line, and returns the positions of the kept
+// points in the input list. It panics if the input is not valid, see OptimalIndicesE for the
+// error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - epsilon (float64): The maximum distance from a removed point to the simplified line.
+//   - opts (...Option): Options of the algorithm, such as WithDistance or WithWindow.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) OptimalIndices(points [][]float64, epsilon float64, opts ...Option) []int {
+	return mustIndices(d.OptimalIndicesE(points, epsilon, opts...))
+}
+
+// OptimalIndicesE simplifies a list of points keeping the smallest number of them such that every
+// removed point lies within epsilon of the simplified line, and returns the positions of the kept
+// points in the input list.
+// This is the method of Imai and Iri: a shortcut joins two points when every point between them
+// lies within epsilon of it, measured as in DouglasPeucker, and the result is the shortest path
+// of shortcuts from the first point to the last one. Since the points kept by DouglasPeucker with
+// the same threshold form one of those paths, the result never has more points.
+//
+// Building the shortcuts takes O(n·w²) time, where w is the number of points spanned by a
+// shortcut, that is n-1 without a window and so O(n³). Inputs whose cost n·w² exceeds
+// OptimalMaxCost are rejected, which allows up to OptimalMaxPoints points without a window and
+// longer inputs when WithWindow bounds w at the cost of optimality. WithPrefilter is applied
+// before the guard.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - epsilon (float64): The maximum distance from a removed point to the simplified line.
+//   - opts (...Option): Options of the algorithm, such as WithDistance or WithWindow.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrInputTooLarge if there are too many points for the window,
+//     ErrInvalidWindow if the window is smaller than one, or another sentinel error if
+//     the input is not valid.
+func (d Decimate) OptimalIndicesE(points [][]float64, epsilon float64, opts ...Option) ([]int, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("epsilon", epsilon); err != nil {
+		return nil, err
+	}
+
+	config := newOptions(opts)
+	if err := validateDistance(config.distance); err != nil {
+		return nil, err
+	}
+	if config.window < 1 {
+		return nil, fmt.Errorf("%w: window must be at least 1, but it is %v", ErrInvalidWindow, config.window)
+	}
+
+	reduced, positions, bounds, err := d.prefilter(points, config)
+	if err != nil {
+		return nil, err
+	}
+
+	last := len(reduced) - 1
+	// The cost is computed in floating point since it overflows an int for long inputs.
+	width := float64(min(config.window, last))
+	if cost := float64(len(reduced)) * width * width; cost > OptimalMaxCost {
+		return nil, fmt.Errorf("%w: %v points with shortcuts spanning %v of them exceed the cost limit of %v", ErrInputTooLarge, len(reduced), width, OptimalMaxCost)
+	}
+
+	// Shortcuts only go forward, so the points are already in topological order and the
+	// shortest paths are found by relaxing the shortcuts leaving every point in turn.
+	count := make([]int, len(reduced))
+	previous := make([]int, len(reduced))
+	for i := range count {
+		count[i] = math.MaxInt
+	}
+	count[0] = 0
+
+	// Shortcuts never skip a pinned vertex, so they end at the next bound at the latest.
+	next := 1
+	for i := 0; i < last; i++ {
+		if i == bounds[next] {
+			next++
+		}
+		end := bounds[next]
+		if config.window < end-i {
+			end = i + config.window
+		}
+		for j := i + 1; j <= end; j++ {
+			if count[i]+1 >= count[j] {
+				continue
+			}
+			index, distance := d.farthestPoint(reduced, i, j, config)
+			if index == -1 || distance <= epsilon {
+				count[j] = count[i] + 1
+				previous[j] = i
+			}
+		}
+	}
+
+	indices := make([]int, count[last]+1)
+	for i, position := len(indices)-1, last; i >= 0; i-- {
+		indices[i] = position
+		position = previous[position]
+	}
+
+	return d.splitLongSegments(points, restoreIndices(indices, positions), config.maxSegmentLength), nil
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"github.com/cenieto/decimate/pkg/interfaces"
+	"math"
+)
+
+// DistanceMode selects how the deviation of a point from the simplified polyline is measured.
+type DistanceMode int
+
+const (
+	// LineDistance measures the distance to the infinite line through the end points of a segment.
+	LineDistance DistanceMode = iota
+	// SegmentDistance measures the distance to the segment itself, so points beyond its end points,
+	// such as the turning point of a track that doubles back on itself, are never considered close.
+	SegmentDistance
+)
+
+// Option configures the behaviour of a decimation algorithm.
+type Option func(*options)
+
+// options holds the configuration of a decimation algorithm, built from a list of Option.
+type options struct {
+	distance         DistanceMode                    // How the deviation of a point is measured
+	prefilter        Prefilter                       // Reduction run before the algorithm, nil for none
+	window           int                             // Maximum number of points spanned by a simplified segment
+	minArea          float64                         // Smallest area of a kept ring
+	pinned           []int                           // Positions of the vertices that are always kept
+	cornerAngle      float64                         // Smallest turning angle of a vertex that is always kept
+	maxSegmentLength float64                         // Longest segment of the simplified polyline
+	maxInterval      float64                         // Longest time between archived points of a time series
+	synchronized     interfaces.SynchronizedGeometry // Geometry measuring synchronized distances, set by TDTR
+}
+
+// newOptions builds the configuration of a decimation algorithm from a list of Option.
+//
+// Parameters:
+//   - opts ([]Option): The options given by the caller.
+//
+// Returns:
+//   - options: The resulting configuration.
+func newOptions(opts []Option) options {
+	result := options{distance: LineDistance, window: math.MaxInt, cornerAngle: math.Inf(1), maxSegmentLength: math.Inf(1), maxInterval: math.Inf(1)}
+	for _, option := range opts {
+		option(&result)
+	}
+	return result
+}
+
+// WithDistance selects how the deviation of a point from the simplified polyline is measured.
+// The default is LineDistance, and values other than the DistanceMode constants are rejected with
+// ErrInvalidParameter.
+//
+// Parameters:
+//   - mode (DistanceMode): The distance to be used.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func WithDistance(mode DistanceMode) Option {
+	return func(o *options) {
+		o.distance = mode
+	}
+}
+
+// withSynchronized measures the deviation of a point as its synchronized Euclidean distance to the
+// simplified trajectory, which replaces the distance mode. It is set by TDTR once the geometry is
+// known to have timestamps.
+//
+// Parameters:
+//   - geometry (interfaces.SynchronizedGeometry): The geometry measuring synchronized distances.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func withSynchronized(geometry interfaces.SynchronizedGeometry) Option {
+	return func(o *options) {
+		o.synchronized = geometry
+	}
+}
+
+// WithPrefilter runs a cheap reduction, such as RadialDistancePrefilter, before the Douglas-Peucker
+// family or Optimal, which then only visit the points kept by the pre-filter. Returned positions still
+// refer to the input list. By default no pre-filter is run.
+//
+// Parameters:
+//   - prefilter (Prefilter): The pre-filter to be run.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func WithPrefilter(prefilter Prefilter) Option {
+	return func(o *options) {
+		o.prefilter = prefilter
+	}
+}
+
+// WithWindow bounds the number of points spanned by a simplified segment in Optimal, which
+// trades optimality for a cost linear in the number of points. By default segments are unbounded.
+//
+// Parameters:
+//   - size (int): The maximum number of points spanned by a simplified segment, at least one.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func WithWindow(size int) Option {
+	return func(o *options) {
+		o.window = size
+	}
+}
+
+// WithMinArea drops the holes of a polygon, and the polygons of a collection, whose rings enclose
+// an area smaller than the given one after the simplification. By default no ring is dropped.
+//
+// Parameters:
+//   - area (float64): The smallest area of a kept ring, in squared units of the coordinates.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func WithMinArea(area float64) Option {
+	return func(o *options) {
+		o.minArea = area
+	}
+}
+
+// WithPinned forces the vertices at the given positions of the input to be kept, such as the
+// stops of a route or the corners shared with another feature. Pinned vertices split the points
+// into sections that are simplified independently, as if every section were an input on its own.
+// The collection and polygon algorithms reject this option with ErrInvalidParameter, as their
+// inputs hold several lines or rings. By default no vertex is pinned.
+//
+// Parameters:
+//   - indices (...int): The positions of the pinned vertices in the input, in any order.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func WithPinned(indices ...int) Option {
+	return func(o *options) {
+		o.pinned = append(o.pinned, indices...)
+	}
+}
+
+// WithCornerAngle forces the vertices where the polyline turns by more than the given angle to be
+// kept, even when they lie within the threshold of the simplified line, such as the corners of a
+// building footprint or a machining path. The turning angle is the angle between the directions of
+// the segments arriving at and leaving a vertex, so it is zero for a straight run and π/2 for a
+// right-angled corner. It is computed from the distances between the three vertices measured by
+// the geometry, so it also holds for the geodesic and projected geometries. Corners are then
+// handled as pinned vertices, see WithPinned, so DouglasPeuckerN keeps every corner even when
+// there are more of them than its budget, as happens on noisy tracks with a small angle. The
+// collection and polygon algorithms reject this option with ErrInvalidParameter. By default no
+// corner is forced.
+//
+// Parameters:
+//   - angle (float64): The turning angle, in radians, above which a vertex is always kept.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func WithCornerAngle(angle float64) Option {
+	return func(o *options) {
+		o.cornerAngle = angle
+	}
+}
+
+// WithMaxSegmentLength bounds the length of the segments of the simplified polyline, so that long
+// straight runs keep intermediate vertices. Vertices of the input are added to every longer
+// segment, so a segment may only remain longer when it joins consecutive input points. Pinned
+// vertices, corners and this bound may make DouglasPeuckerN keep more points than its budget, and
+// SimplificationIndex and the collection and polygon algorithms ignore this option. By default
+// segments are unbounded.
+//
+// Parameters:
+//   - length (float64): The maximum length of a segment of the simplified polyline.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func WithMaxSegmentLength(length float64) Option {
+	return func(o *options) {
+		o.maxSegmentLength = length
+	}
+}
+
+// WithMaxInterval bounds the time between the points archived by SwingingDoor and Deadband, as the
+// maximum time between archived points of a process historian. Whenever a point arrives later
+// than the interval after the last archived point, the point before it is archived, so only gaps
+// in the series itself remain longer. By default the time between archived points is unbounded.
+//
+// Parameters:
+//   - interval (float64): The maximum time between archived points, in units of the first
+//     coordinate.
+//
+// Returns:
+//   - Option: The option to be passed to a decimation algorithm.
+func WithMaxInterval(interval float64) Option {
+	return func(o *options) {
+		o.maxInterval = interval
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import "fmt"
+
+// PerpendicularDistance reduces a list of points by removing the points closer than tol to the
+// line joining their neighbours. It panics if the input is not valid, see PerpendicularDistanceE
+// for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - tol (float64): The minimum distance from a kept point to the line joining its neighbours.
+//   - repeat (int): The maximum number of passes over the list of points.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The reduced list of points.
+func (d Decimate) PerpendicularDistance(points [][]float64, tol float64, repeat int, opts ...Option) [][]float64 {
+	return mustPoints(d.PerpendicularDistanceE(points, tol, repeat, opts...))
+}
+
+// PerpendicularDistanceE reduces a list of points by removing the points closer than tol to the
+// line joining their neighbours.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - tol (float64): The minimum distance from a kept point to the line joining its neighbours.
+//   - repeat (int): The maximum number of passes over the list of points.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The reduced list of points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) PerpendicularDistanceE(points [][]float64, tol float64, repeat int, opts ...Option) ([][]float64, error) {
+	indices, err := d.PerpendicularDistanceIndicesE(points, tol, repeat, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// PerpendicularDistanceIndices reduces a list of points by removing the points closer than tol to
+// the line joining their neighbours, and returns the positions of the kept points in the input
+// list. It panics if the input is not valid, see PerpendicularDistanceIndicesE for the
+// error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - tol (float64): The minimum distance from a kept point to the line joining its neighbours.
+//   - repeat (int): The maximum number of passes over the list of points.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) PerpendicularDistanceIndices(points [][]float64, tol float64, repeat int, opts ...Option) []int {
+	return mustIndices(d.PerpendicularDistanceIndicesE(points, tol, repeat, opts...))
+}
+
+// PerpendicularDistanceIndicesE reduces a list of points by removing the points closer than tol
+// to the line joining their neighbours, and returns the positions of the kept points in the input
+// list.
+// Every pass visits the points in order and removes a point when it lies closer than tol to the
+// line joining the last kept point and the next point. The next point is then kept, so two
+// consecutive points are never removed in the same pass. Passes are repeated on the reduced list
+// up to repeat times, or until a pass removes no point. Every pass runs in linear time.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - tol (float64): The minimum distance from a kept point to the line joining its neighbours.
+//   - repeat (int): The maximum number of passes over the list of points.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid, or
+//     ErrInvalidRepeat if repeat is smaller than one.
+func (d Decimate) PerpendicularDistanceIndicesE(points [][]float64, tol float64, repeat int, opts ...Option) ([]int, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("tol", tol); err != nil {
+		return nil, err
+	}
+	if repeat < 1 {
+		return nil, fmt.Errorf("%w: repeat must be at least 1, but it is %v", ErrInvalidRepeat, repeat)
+	}
+
+	config := newOptions(opts)
+	return d.splitAtPinned(points, config, func(section [][]float64) []int {
+		return d.perpendicularDistance(section, tol, repeat)
+	})
+}
+
+// perpendicularDistance runs the perpendicular distance algorithm over a section of the points between
+// pinned vertices.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - tol (float64): The minimum distance from a kept point to the line joining its neighbours.
+//   - repeat (int): The maximum number of passes over the list of points.
+//
+// Returns:
+//   - []int: The positions of the kept points in the section, in increasing order.
+func (d Decimate) perpendicularDistance(points [][]float64, tol float64, repeat int) []int {
+	indices := make([]int, len(points))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	for pass := 0; pass < repeat; pass++ {
+		reduced := d.perpendicularDistancePass(points, indices, tol)
+		if len(reduced) == len(indices) {
+			break
+		}
+		indices = reduced
+	}
+
+	return indices
+}
+
+// perpendicularDistancePass runs a single pass of the perpendicular distance reduction over the
+// points at the given positions.
+//
+// Parameters:
+//   - points ([][]float64): The list of points.
+//   - indices ([]int): The positions of the points to be reduced, in increasing order.
+//   - tol (float64): The minimum distance from a kept point to the line joining its neighbours.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) perpendicularDistancePass(points [][]float64, indices []int, tol float64) []int {
+	geometry := d.coordinates()
+	last := len(indices) - 1
+
+	result := []int{indices[0]}
+	for i := 1; i < last; i++ {
+		key := points[result[len(result)-1]]
+		if geometry.DistancePointLineCoordinates(points[indices[i]], key, points[indices[i+1]]) < tol {
+			i++
+		}
+		result = append(result, indices[i])
+	}
+
+	if result[len(result)-1] != indices[last] {
+		result = append(result, indices[last])
+	}
+	return result
+}
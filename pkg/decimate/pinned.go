@@ -0,0 +1,79 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"fmt"
+	"sort"
+)
+
+// pinnedBounds returns the positions splitting a list of points into the sections between pinned
+// vertices: the first and last positions and the pinned ones, sorted and without duplicates.
+//
+// Parameters:
+//   - pinned ([]int): The positions of the pinned vertices, in any order.
+//   - size (int): The number of points of the list.
+//
+// Returns:
+//   - []int: The positions of the bounds of the sections, in increasing order.
+//   - error: An error wrapping ErrPinnedOutOfRange if a pinned position is not in the list.
+func pinnedBounds(pinned []int, size int) ([]int, error) {
+	bounds := make([]int, 0, len(pinned)+2)
+	bounds = append(bounds, 0, size-1)
+	for _, index := range pinned {
+		if index < 0 || index >= size {
+			return nil, fmt.Errorf("%w: pinned position %v is not in a list of %v points", ErrPinnedOutOfRange, index, size)
+		}
+		bounds = append(bounds, index)
+	}
+	sort.Ints(bounds)
+
+	unique := bounds[:1]
+	for _, index := range bounds[1:] {
+		if index != unique[len(unique)-1] {
+			unique = append(unique, index)
+		}
+	}
+	return unique, nil
+}
+
+// splitAtPinned runs a simplification on every section of a list of points between pinned
+// vertices and corners, which are so always kept, and merges the positions kept in every section.
+// Segments longer than the maximum segment length of the configuration are then split.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified, already validated.
+//   - config (options): The configuration of the decimation algorithm.
+//   - simplify (func([][]float64) []int): The simplification, returning the positions kept in a
+//     section, including its first and last ones.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrPinnedOutOfRange if a pinned position is not in the list, or
+//     ErrNegativeThreshold if the corner angle or the maximum segment length is not valid.
+func (d Decimate) splitAtPinned(points [][]float64, config options, simplify func(section [][]float64) []int) ([]int, error) {
+	bounds, err := d.sectionBounds(points, config)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []int{0}
+	for k := 1; k < len(bounds); k++ {
+		first := bounds[k-1]
+		for _, index := range simplify(points[first : bounds[k]+1])[1:] {
+			result = append(result, first+index)
+		}
+	}
+	return d.splitLongSegments(points, result, config.maxSegmentLength), nil
+}
@@ -0,0 +1,408 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Polygon is a 2D polygon made of an outer ring and any number of holes. Rings are closed, their
+// last point repeating the first one.
+type Polygon struct {
+	Outer [][]float64   // The points of the outer ring
+	Holes [][][]float64 // The points of every hole
+}
+
+// PolygonIndices holds the positions of the kept points of every ring of a simplified polygon.
+type PolygonIndices struct {
+	Outer []int   // Positions of the kept points of the outer ring, nil if the polygon is dropped
+	Holes [][]int // Positions of the kept points of every hole, nil if the hole is dropped
+}
+
+// SimplifyPolygon simplifies the rings of a polygon with the Douglas-Peucker algorithm.
+// It panics if the input is not valid, see SimplifyPolygonE for the error-returning form.
+//
+// Parameters:
+//   - polygon (Polygon): The polygon to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithMinArea.
+//
+// Returns:
+//   - Polygon: The simplified polygon.
+func (d Decimate) SimplifyPolygon(polygon Polygon, tol float64, opts ...Option) Polygon {
+	result, err := d.SimplifyPolygonE(polygon, tol, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// SimplifyPolygonE simplifies the rings of a polygon with the Douglas-Peucker algorithm.
+//
+// Parameters:
+//   - polygon (Polygon): The polygon to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithMinArea.
+//
+// Returns:
+//   - Polygon: The simplified polygon.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) SimplifyPolygonE(polygon Polygon, tol float64, opts ...Option) (Polygon, error) {
+	indices, err := d.SimplifyPolygonIndicesE(polygon, tol, opts...)
+	if err != nil {
+		return Polygon{}, err
+	}
+	return selectPolygon(polygon, indices), nil
+}
+
+// SimplifyPolygonIndices simplifies the rings of a polygon with the Douglas-Peucker algorithm and
+// returns the positions of the kept points of every ring. It panics if the input is not valid,
+// see SimplifyPolygonIndicesE for the error-returning form.
+//
+// Parameters:
+//   - polygon (Polygon): The polygon to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithMinArea.
+//
+// Returns:
+//   - PolygonIndices: The positions of the kept points of every ring, in increasing order.
+func (d Decimate) SimplifyPolygonIndices(polygon Polygon, tol float64, opts ...Option) PolygonIndices {
+	indices, err := d.SimplifyPolygonIndicesE(polygon, tol, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return indices
+}
+
+// SimplifyPolygonIndicesE simplifies the rings of a polygon with the Douglas-Peucker algorithm and
+// returns the positions of the kept points of every ring.
+// Every ring stays closed and keeps at least three distinct points, so that it still encloses a
+// triangle, and its orientation, clockwise or counterclockwise, is kept: while it is not, the
+// point deviating the most from the simplified ring is added back. Holes whose area after the
+// simplification is smaller than the one given with WithMinArea are dropped, while the outer ring
+// is always kept.
+//
+// Parameters:
+//   - polygon (Polygon): The polygon to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithMinArea.
+//
+// Returns:
+//   - PolygonIndices: The positions of the kept points of every ring, in increasing order.
+//   - error: An error wrapping ErrInvalidRing if a ring is not closed or has less than four
+//     points, ErrUnsupportedDimension if the points are not 2D, ErrInvalidParameter if WithPinned
+//     or WithCornerAngle is given, or another sentinel error if the input is not valid.
+func (d Decimate) SimplifyPolygonIndicesE(polygon Polygon, tol float64, opts ...Option) (PolygonIndices, error) {
+	if err := d.validatePolygon(polygon); err != nil {
+		return PolygonIndices{}, err
+	}
+	if err := validateThreshold("tol", tol); err != nil {
+		return PolygonIndices{}, err
+	}
+
+	config := newOptions(opts)
+	if err := validateDistance(config.distance); err != nil {
+		return PolygonIndices{}, err
+	}
+	if err := validateCollectionOptions(config); err != nil {
+		return PolygonIndices{}, err
+	}
+	if err := validateThreshold("minArea", config.minArea); err != nil {
+		return PolygonIndices{}, err
+	}
+
+	return d.simplifyPolygon(polygon, tol, config), nil
+}
+
+// SimplifyMultiPolygon simplifies the rings of a collection of polygons with the Douglas-Peucker
+// algorithm. It panics if the input is not valid, see SimplifyMultiPolygonE for the
+// error-returning form.
+//
+// Parameters:
+//   - polygons ([]Polygon): The polygons to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithMinArea.
+//
+// Returns:
+//   - []Polygon: The simplified polygons, in the order of the input, without the dropped ones.
+func (d Decimate) SimplifyMultiPolygon(polygons []Polygon, tol float64, opts ...Option) []Polygon {
+	result, err := d.SimplifyMultiPolygonE(polygons, tol, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// SimplifyMultiPolygonE simplifies the rings of a collection of polygons with the Douglas-Peucker
+// algorithm.
+//
+// Parameters:
+//   - polygons ([]Polygon): The polygons to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithMinArea.
+//
+// Returns:
+//   - []Polygon: The simplified polygons, in the order of the input, without the dropped ones.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) SimplifyMultiPolygonE(polygons []Polygon, tol float64, opts ...Option) ([]Polygon, error) {
+	indices, err := d.SimplifyMultiPolygonIndicesE(polygons, tol, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Polygon
+	for p, polygon := range polygons {
+		if indices[p].Outer != nil {
+			result = append(result, selectPolygon(polygon, indices[p]))
+		}
+	}
+	return result, nil
+}
+
+// SimplifyMultiPolygonIndices simplifies the rings of a collection of polygons with the
+// Douglas-Peucker algorithm and returns the positions of the kept points of every ring. It panics
+// if the input is not valid, see SimplifyMultiPolygonIndicesE for the error-returning form.
+//
+// Parameters:
+//   - polygons ([]Polygon): The polygons to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithMinArea.
+//
+// Returns:
+//   - []PolygonIndices: The positions of the kept points of every ring, by polygon.
+func (d Decimate) SimplifyMultiPolygonIndices(polygons []Polygon, tol float64, opts ...Option) []PolygonIndices {
+	indices, err := d.SimplifyMultiPolygonIndicesE(polygons, tol, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return indices
+}
+
+// SimplifyMultiPolygonIndicesE simplifies the rings of a collection of polygons with the
+// Douglas-Peucker algorithm and returns the positions of the kept points of every ring.
+// Every polygon is simplified as SimplifyPolygonIndicesE does, and polygons whose outer ring
+// encloses an area smaller than the one given with WithMinArea after the simplification, such as
+// small islands, are dropped: all their positions are nil.
+//
+// Parameters:
+//   - polygons ([]Polygon): The polygons to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithMinArea.
+//
+// Returns:
+//   - []PolygonIndices: The positions of the kept points of every ring, by polygon.
+//   - error: An error wrapping ErrInvalidParameter if WithPinned or WithCornerAngle is given, or
+//     another sentinel error if the input is not valid.
+func (d Decimate) SimplifyMultiPolygonIndicesE(polygons []Polygon, tol float64, opts ...Option) ([]PolygonIndices, error) {
+	if len(polygons) == 0 {
+		return nil, fmt.Errorf("%w: the collection of polygons is empty", ErrTooFewPoints)
+	}
+	for p, polygon := range polygons {
+		if err := d.validatePolygon(polygon); err != nil {
+			return nil, fmt.Errorf("polygon %v: %w", p, err)
+		}
+	}
+	if err := validateThreshold("tol", tol); err != nil {
+		return nil, err
+	}
+
+	config := newOptions(opts)
+	if err := validateDistance(config.distance); err != nil {
+		return nil, err
+	}
+	if err := validateCollectionOptions(config); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("minArea", config.minArea); err != nil {
+		return nil, err
+	}
+
+	result := make([]PolygonIndices, len(polygons))
+	for p, polygon := range polygons {
+		indices := d.simplifyPolygon(polygon, tol, config)
+		if math.Abs(signedArea(SelectPoints(polygon.Outer, indices.Outer))) >= config.minArea {
+			result[p] = indices
+		}
+	}
+	return result, nil
+}
+
+// simplifyPolygon simplifies the rings of a validated polygon, dropping the holes smaller than
+// the minimum area of the configuration.
+//
+// Parameters:
+//   - polygon (Polygon): The polygon to be simplified.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - config (options): The configuration of the algorithm.
+//
+// Returns:
+//   - PolygonIndices: The positions of the kept points of every ring, in increasing order.
+func (d Decimate) simplifyPolygon(polygon Polygon, tol float64, config options) PolygonIndices {
+	result := PolygonIndices{
+		Outer: d.simplifyRing(polygon.Outer, tol, config),
+		Holes: make([][]int, len(polygon.Holes)),
+	}
+	for h, hole := range polygon.Holes {
+		indices := d.simplifyRing(hole, tol, config)
+		if math.Abs(signedArea(SelectPoints(hole, indices))) >= config.minArea {
+			result.Holes[h] = indices
+		}
+	}
+	return result
+}
+
+// simplifyRing simplifies a closed ring with the Douglas-Peucker algorithm, adding back the
+// points deviating the most from the simplified ring until it has three distinct points and the
+// orientation of the input.
+//
+// Parameters:
+//   - ring ([][]float64): The points of the ring.
+//   - tol (float64): The threshold to be used in the simplification.
+//   - config (options): The configuration of the algorithm.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) simplifyRing(ring [][]float64, tol float64, config options) []int {
+	last := len(ring) - 1
+	keep := make([]bool, len(ring))
+	keep[0] = true
+	keep[last] = true
+	d.douglasPeucker(ring, 0, last, tol, config, keep)
+
+	// Kept vertices repeating another kept vertex do not count towards the triangle.
+	indices := keptIndices(keep)
+	for distinctPoints(ring, indices[:len(indices)-1], 3) < 3 && d.keepFarthest(ring, indices, config, keep) {
+		indices = keptIndices(keep)
+	}
+
+	orientation := signedArea(ring)
+	for orientation*signedArea(SelectPoints(ring, indices)) <= 0 && orientation != 0 && d.keepFarthest(ring, indices, config, keep) {
+		indices = keptIndices(keep)
+	}
+
+	return indices
+}
+
+// keepFarthest keeps the point deviating the most from the section of a simplified line it
+// belongs to.
+//
+// Parameters:
+//   - points ([][]float64): The list of points.
+//   - indices ([]int): The positions of the kept points, in increasing order.
+//   - config (options): The configuration of the algorithm.
+//   - keep ([]bool): The kept points, by position.
+//
+// Returns:
+//   - bool: True if a point was kept, false if no point deviates from the simplified line.
+func (d Decimate) keepFarthest(points [][]float64, indices []int, config options, keep []bool) bool {
+	farthest, distanceMaximum := -1, -1.0
+	for k := 1; k < len(indices); k++ {
+		index, distance := d.farthestPoint(points, indices[k-1], indices[k], config)
+		if index != -1 && distance > distanceMaximum {
+			farthest, distanceMaximum = index, distance
+		}
+	}
+
+	if farthest == -1 {
+		return false
+	}
+	keep[farthest] = true
+	return true
+}
+
+// distinctPoints counts the distinct points found at the given positions, up to a limit so that
+// long lists are not compared in full.
+//
+// Parameters:
+//   - points ([][]float64): The list of points.
+//   - indices ([]int): The positions of the points to be counted.
+//   - limit (int): The count at which the search stops.
+//
+// Returns:
+//   - int: The number of distinct points, at most limit.
+func distinctPoints(points [][]float64, indices []int, limit int) int {
+	distinct := make([][]float64, 0, limit)
+	for _, index := range indices {
+		if len(distinct) == limit {
+			break
+		}
+		if !slices.ContainsFunc(distinct, func(point []float64) bool { return equalCoordinates(point, points[index]) }) {
+			distinct = append(distinct, points[index])
+		}
+	}
+	return len(distinct)
+}
+
+// signedArea computes the area enclosed by a closed 2D ring with the shoelace formula. It is
+// positive when the ring is counterclockwise and negative when it is clockwise.
+//
+// Parameters:
+//   - ring ([][]float64): The points of the ring, the last one repeating the first one.
+//
+// Returns:
+//   - float64: The signed area of the ring.
+func signedArea(ring [][]float64) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	return area / 2
+}
+
+// selectPolygon returns the points of a polygon found at the given positions, without the
+// dropped holes.
+//
+// Parameters:
+//   - polygon (Polygon): The polygon.
+//   - indices (PolygonIndices): The positions of the points to be selected in every ring.
+//
+// Returns:
+//   - Polygon: The selected polygon. The coordinates are shared with the input polygon.
+func selectPolygon(polygon Polygon, indices PolygonIndices) Polygon {
+	result := Polygon{Outer: SelectPoints(polygon.Outer, indices.Outer)}
+	for h, hole := range polygon.Holes {
+		if indices.Holes[h] != nil {
+			result.Holes = append(result.Holes, SelectPoints(hole, indices.Holes[h]))
+		}
+	}
+	return result
+}
+
+// validatePolygon checks the input polygon of a decimation algorithm.
+//
+// Parameters:
+//   - polygon (Polygon): The polygon to be validated.
+//
+// Returns:
+//   - error: An error wrapping ErrInvalidRing if a ring is not closed or has less than four
+//     points, or another sentinel error if the rings are not valid 2D lines.
+//   - nil: If the input is valid.
+func (d Decimate) validatePolygon(polygon Polygon) error {
+	rings := append([][][]float64{polygon.Outer}, polygon.Holes...)
+	if err := d.validatePlanarLines(rings); err != nil {
+		return err
+	}
+
+	for r, ring := range rings {
+		if len(ring) < 4 {
+			return fmt.Errorf("%w: ring %v has %v points, but at least 4 are needed to enclose a triangle", ErrInvalidRing, r, len(ring))
+		}
+		if !equalCoordinates(ring[0], ring[len(ring)-1]) {
+			return fmt.Errorf("%w: ring %v is not closed, its last point must repeat its first one", ErrInvalidRing, r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import "fmt"
+
+// Prefilter is a cheap reduction of a list of points, run by the Douglas-Peucker family and
+// Optimal before the expensive pass when given through WithPrefilter. It returns the positions of the kept
+// points in the input list, in increasing order, which must include its first and last points.
+// Results breaking these rules are rejected with ErrInvalidParameter.
+type Prefilter func(d Decimate, points [][]float64) ([]int, error)
+
+// RadialDistancePrefilter returns a Prefilter running RadialDistance with the given distance.
+//
+// Parameters:
+//   - minDist (float64): The minimum distance between consecutive kept points.
+//
+// Returns:
+//   - Prefilter: The pre-filter to be passed to WithPrefilter.
+func RadialDistancePrefilter(minDist float64) Prefilter {
+	return func(d Decimate, points [][]float64) ([]int, error) {
+		return d.RadialDistanceIndicesE(points, minDist)
+	}
+}
+
+// PerpendicularDistancePrefilter returns a Prefilter running PerpendicularDistance with the given
+// tolerance and number of passes.
+//
+// Parameters:
+//   - tol (float64): The minimum distance from a kept point to the line joining its neighbours.
+//   - repeat (int): The maximum number of passes over the list of points.
+//
+// Returns:
+//   - Prefilter: The pre-filter to be passed to WithPrefilter.
+func PerpendicularDistancePrefilter(tol float64, repeat int) Prefilter {
+	return func(d Decimate, points [][]float64) ([]int, error) {
+		return d.PerpendicularDistanceIndicesE(points, tol, repeat)
+	}
+}
+
+// prefilter runs the pre-filter of the configuration, if any, over a list of points. The pre-filter
+// is run over every section between pinned vertices and corners, so that they are never removed.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - config (options): The configuration of the decimation algorithm.
+//
+// Returns:
+//   - [][]float64: The reduced list of points, or the input list if there is no pre-filter.
+//   - []int: The positions of the reduced points in the input list, or nil if there is no
+//     pre-filter.
+//   - []int: The positions of the first, last, pinned and corner points in the reduced list, in
+//     increasing order.
+//   - error: An error wrapping ErrPinnedOutOfRange or ErrNegativeThreshold if the configuration
+//     is not valid, ErrInvalidParameter if the pre-filter returns invalid positions, or the error
+//     returned by the pre-filter.
+func (d Decimate) prefilter(points [][]float64, config options) ([][]float64, []int, []int, error) {
+	bounds, err := d.sectionBounds(points, config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if config.prefilter == nil {
+		return points, nil, bounds, nil
+	}
+
+	positions := []int{0}
+	reducedBounds := []int{0}
+	for k := 1; k < len(bounds); k++ {
+		first := bounds[k-1]
+		section, err := config.prefilter(d, points[first:bounds[k]+1])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if err := validatePrefiltered(section, bounds[k]-first); err != nil {
+			return nil, nil, nil, fmt.Errorf("section from %v to %v: %w", first, bounds[k], err)
+		}
+		for _, index := range section[1:] {
+			positions = append(positions, first+index)
+		}
+		reducedBounds = append(reducedBounds, len(positions)-1)
+	}
+	return SelectPoints(points, positions), positions, reducedBounds, nil
+}
+
+// validatePrefiltered checks the positions returned by a pre-filter over a section.
+//
+// Parameters:
+//   - indices ([]int): The positions returned by the pre-filter.
+//   - last (int): The last position of the section.
+//
+// Returns:
+//   - error: An error wrapping ErrInvalidParameter if the positions do not start at 0, end at last
+//     and increase strictly.
+//   - nil: If the positions are valid.
+func validatePrefiltered(indices []int, last int) error {
+	if len(indices) < 2 || indices[0] != 0 || indices[len(indices)-1] != last {
+		return fmt.Errorf("%w: pre-filter positions must start at 0 and end at %v, but %v positions were returned", ErrInvalidParameter, last, len(indices))
+	}
+	for k := 1; k < len(indices); k++ {
+		if indices[k] <= indices[k-1] {
+			return fmt.Errorf("%w: pre-filter positions must be strictly increasing, but %v follows %v", ErrInvalidParameter, indices[k], indices[k-1])
+		}
+	}
+	return nil
+}
+
+// restoreIndices maps positions in a list reduced by a pre-filter back to positions in its input
+// list, in place.
+//
+// Parameters:
+//   - indices ([]int): The positions in the reduced list.
+//   - positions ([]int): The positions of the reduced points in the input list, or nil if there
+//     was no pre-filter.
+//
+// Returns:
+//   - []int: The positions in the input list.
+func restoreIndices(indices, positions []int) []int {
+	if positions == nil {
+		return indices
+	}
+	for i, index := range indices {
+		indices[i] = positions[index]
+	}
+	return indices
+}
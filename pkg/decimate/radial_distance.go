@@ -0,0 +1,115 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+// RadialDistance reduces a list of points by removing the points closer than minDist to the last
+// kept point. It panics if the input is not valid, see RadialDistanceE for the error-returning
+// form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - minDist (float64): The minimum distance between consecutive kept points.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The reduced list of points.
+func (d Decimate) RadialDistance(points [][]float64, minDist float64, opts ...Option) [][]float64 {
+	return mustPoints(d.RadialDistanceE(points, minDist, opts...))
+}
+
+// RadialDistanceE reduces a list of points by removing the points closer than minDist to the last
+// kept point.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - minDist (float64): The minimum distance between consecutive kept points.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The reduced list of points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) RadialDistanceE(points [][]float64, minDist float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.RadialDistanceIndicesE(points, minDist, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// RadialDistanceIndices reduces a list of points by removing the points closer than minDist to
+// the last kept point, and returns the positions of the kept points in the input list. It panics
+// if the input is not valid, see RadialDistanceIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - minDist (float64): The minimum distance between consecutive kept points.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) RadialDistanceIndices(points [][]float64, minDist float64, opts ...Option) []int {
+	return mustIndices(d.RadialDistanceIndicesE(points, minDist, opts...))
+}
+
+// RadialDistanceIndicesE reduces a list of points by removing the points closer than minDist to
+// the last kept point, and returns the positions of the kept points in the input list.
+// Clusters of near-duplicate points, such as the ones recorded while a receiver stands still,
+// are collapsed into their first point. The last point is always kept, even if it is closer
+// than minDist to the previous kept point. It runs in linear time.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - minDist (float64): The minimum distance between consecutive kept points.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) RadialDistanceIndicesE(points [][]float64, minDist float64, opts ...Option) ([]int, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("minDist", minDist); err != nil {
+		return nil, err
+	}
+
+	config := newOptions(opts)
+	return d.splitAtPinned(points, config, func(section [][]float64) []int {
+		return d.radialDistance(section, minDist)
+	})
+}
+
+// radialDistance runs the radial distance algorithm over a section of the points between
+// pinned vertices.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be reduced.
+//   - minDist (float64): The minimum distance between consecutive kept points.
+//
+// Returns:
+//   - []int: The positions of the kept points in the section, in increasing order.
+func (d Decimate) radialDistance(points [][]float64, minDist float64) []int {
+	last := len(points) - 1
+
+	indices := []int{0}
+	key := 0
+	for i := 1; i < last; i++ {
+		if d.pointDistance(points[key], points[i]) >= minDist {
+			indices = append(indices, i)
+			key = i
+		}
+	}
+
+	return append(indices, last)
+}
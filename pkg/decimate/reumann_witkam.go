@@ -0,0 +1,115 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+// ReumannWitkam simplifies a list of points using the Reumann-Witkam algorithm.
+// It panics if the input is not valid, see ReumannWitkamE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The half width of the strip around the current key segment.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func (d Decimate) ReumannWitkam(points [][]float64, tolerance float64, opts ...Option) [][]float64 {
+	return mustPoints(d.ReumannWitkamE(points, tolerance, opts...))
+}
+
+// ReumannWitkamE simplifies a list of points using the Reumann-Witkam algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The half width of the strip around the current key segment.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) ReumannWitkamE(points [][]float64, tolerance float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.ReumannWitkamIndicesE(points, tolerance, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// ReumannWitkamIndices simplifies a list of points using the Reumann-Witkam algorithm and returns
+// the positions of the kept points in the input list. It panics if the input is not valid, see
+// ReumannWitkamIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The half width of the strip around the current key segment.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) ReumannWitkamIndices(points [][]float64, tolerance float64, opts ...Option) []int {
+	return mustIndices(d.ReumannWitkamIndicesE(points, tolerance, opts...))
+}
+
+// ReumannWitkamIndicesE simplifies a list of points using the Reumann-Witkam algorithm and returns
+// the positions of the kept points in the input list.
+// The line going through the current key point and the point after it defines a strip of half
+// width tolerance. Points are visited in order, and the first point lying outside the strip makes
+// the point before it the new key point. Every point is visited once and never looked back at, so
+// the algorithm runs in linear time.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The half width of the strip around the current key segment.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) ReumannWitkamIndicesE(points [][]float64, tolerance float64, opts ...Option) ([]int, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("tolerance", tolerance); err != nil {
+		return nil, err
+	}
+
+	config := newOptions(opts)
+	return d.splitAtPinned(points, config, func(section [][]float64) []int {
+		return d.reumannWitkam(section, tolerance)
+	})
+}
+
+// reumannWitkam runs the Reumann-Witkam algorithm over a section of the points between
+// pinned vertices.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - tolerance (float64): The half width of the strip around the current key segment.
+//
+// Returns:
+//   - []int: The positions of the kept points in the section, in increasing order.
+func (d Decimate) reumannWitkam(points [][]float64, tolerance float64) []int {
+	geometry := d.coordinates()
+	last := len(points) - 1
+
+	indices := []int{0}
+	key, next := 0, 1
+	for i := 2; i <= last; i++ {
+		if geometry.DistancePointLineCoordinates(points[i], points[key], points[next]) > tolerance {
+			indices = append(indices, i-1)
+			key, next = i-1, i
+		}
+	}
+
+	return append(indices, last)
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+// SelectPoints returns the points found at the given positions of a point list.
+// It projects the result of any index-returning decimation method back onto coordinates, and
+// the same positions can be used to select entries of any attribute list parallel to points.
+//
+// Parameters:
+//   - points ([][]float64): The list of points.
+//   - indices ([]int): The positions of the points to be selected.
+//
+// Returns:
+//   - [][]float64: The selected points. The coordinates are shared with the input list.
+func SelectPoints(points [][]float64, indices []int) [][]float64 {
+	result := make([][]float64, len(indices))
+	for i, index := range indices {
+		result[i] = points[index]
+	}
+	return result
+}
+
+// mustIndices panics if err is not nil, otherwise it returns the given indices.
+// It is used to build the panicking forms of the index-returning decimation methods.
+func mustIndices(indices []int, err error) []int {
+	if err != nil {
+		panic(err)
+	}
+	return indices
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// SimplifyShared simplifies a collection of lines and rings with the Douglas-Peucker algorithm,
+// simplifying the boundaries they share exactly once so that they stay identical. It panics if
+// the input is not valid, see SimplifySharedE for the error-returning form.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines and rings to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][][]float64: The simplified lines, in the order of the input.
+func (d Decimate) SimplifyShared(lines [][][]float64, threshold float64, opts ...Option) [][][]float64 {
+	result, err := d.SimplifySharedE(lines, threshold, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// SimplifySharedE simplifies a collection of lines and rings with the Douglas-Peucker algorithm,
+// simplifying the boundaries they share exactly once so that they stay identical.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines and rings to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][][]float64: The simplified lines, in the order of the input.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) SimplifySharedE(lines [][][]float64, threshold float64, opts ...Option) ([][][]float64, error) {
+	indices, err := d.SimplifySharedIndicesE(lines, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][][]float64, len(lines))
+	for l, points := range lines {
+		result[l] = SelectPoints(points, indices[l])
+	}
+	return result, nil
+}
+
+// SimplifySharedIndices simplifies a collection of lines and rings with the Douglas-Peucker
+// algorithm, simplifying the boundaries they share exactly once, and returns the positions of the
+// kept points in every line. It panics if the input is not valid, see SimplifySharedIndicesE for
+// the error-returning form.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines and rings to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][]int: The positions of the kept points of every line, in increasing order.
+func (d Decimate) SimplifySharedIndices(lines [][][]float64, threshold float64, opts ...Option) [][]int {
+	indices, err := d.SimplifySharedIndicesE(lines, threshold, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return indices
+}
+
+// SimplifySharedIndicesE simplifies a collection of lines and rings with the Douglas-Peucker
+// algorithm, simplifying the boundaries they share exactly once, and returns the positions of the
+// kept points in every line.
+// As in TopoJSON, lines are cut into arcs at junctions: the ends of every line, and the points
+// shared by several lines with different neighbours, where shared boundaries begin or end. Every
+// distinct arc, in either direction, is simplified once, and every line is rebuilt from the
+// simplification of its arcs. Adjacent polygons simplified this way leave no slivers or gaps
+// between them. Points are matched by their exact coordinates.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines and rings to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][]int: The positions of the kept points of every line, in increasing order.
+//   - error: An error wrapping ErrInvalidParameter if WithPinned or WithCornerAngle is given, or
+//     another sentinel error if the input is not valid.
+func (d Decimate) SimplifySharedIndicesE(lines [][][]float64, threshold float64, opts ...Option) ([][]int, error) {
+	if err := d.validateLines(lines); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("threshold", threshold); err != nil {
+		return nil, err
+	}
+
+	config := newOptions(opts)
+	if err := validateDistance(config.distance); err != nil {
+		return nil, err
+	}
+	if err := validateCollectionOptions(config); err != nil {
+		return nil, err
+	}
+	junctions := findJunctions(lines)
+	arcs := make(map[string][]int)
+
+	result := make([][]int, len(lines))
+	for l, points := range lines {
+		last := len(points) - 1
+		keep := make([]bool, len(points))
+
+		first := 0
+		for i := 1; i <= last; i++ {
+			if i == last || junctions[coordinateKey(points[i])] {
+				d.simplifyArc(points, first, i, threshold, config, arcs, keep)
+				first = i
+			}
+		}
+
+		result[l] = keptIndices(keep)
+	}
+	return result, nil
+}
+
+// simplifyArc simplifies the arc of a line between two junctions, reusing the simplification of
+// the same arc found in an earlier line, in either direction.
+//
+// Parameters:
+//   - points ([][]float64): The points of the line.
+//   - first (int): The position of the start of the arc in the line.
+//   - last (int): The position of the end of the arc in the line.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - config (options): The configuration of the algorithm.
+//   - arcs (map[string][]int): The kept positions of the simplified arcs, by arc key.
+//   - keep ([]bool): The kept points of the line, by position.
+func (d Decimate) simplifyArc(points [][]float64, first, last int, threshold float64, config options, arcs map[string][]int, keep []bool) {
+	var forward, backward []byte
+	for i := first; i <= last; i++ {
+		forward = append(forward, coordinateKey(points[i])...)
+	}
+	for i := last; i >= first; i-- {
+		backward = append(backward, coordinateKey(points[i])...)
+	}
+
+	// Arcs are simplified in the direction with the smallest key, so that both directions of
+	// the same arc are simplified the same way.
+	reversed := string(backward) < string(forward)
+	key := string(forward)
+	if reversed {
+		key = string(backward)
+	}
+
+	kept, ok := arcs[key]
+	if !ok {
+		arc := make([][]float64, last-first+1)
+		for i := range arc {
+			if reversed {
+				arc[i] = points[last-i]
+			} else {
+				arc[i] = points[first+i]
+			}
+		}
+
+		arcKeep := make([]bool, len(arc))
+		arcKeep[0] = true
+		arcKeep[len(arc)-1] = true
+		d.douglasPeucker(arc, 0, len(arc)-1, threshold, config, arcKeep)
+		kept = keptIndices(arcKeep)
+		arcs[key] = kept
+	}
+
+	for _, offset := range kept {
+		if reversed {
+			keep[last-offset] = true
+		} else {
+			keep[first+offset] = true
+		}
+	}
+}
+
+// findJunctions finds the junctions of a collection of lines: the ends of every line, and the
+// points found in several places with different neighbours.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines.
+//
+// Returns:
+//   - map[string]bool: The coordinate keys of the junctions.
+func findJunctions(lines [][][]float64) map[string]bool {
+	junctions := make(map[string]bool)
+	neighbours := make(map[string]string)
+
+	for _, points := range lines {
+		last := len(points) - 1
+		junctions[coordinateKey(points[0])] = true
+		junctions[coordinateKey(points[last])] = true
+
+		for i := 1; i < last; i++ {
+			key := coordinateKey(points[i])
+			previous, next := coordinateKey(points[i-1]), coordinateKey(points[i+1])
+			pair := previous + next
+			if next < previous {
+				pair = next + previous
+			}
+
+			if seen, ok := neighbours[key]; !ok {
+				neighbours[key] = pair
+			} else if seen != pair {
+				junctions[key] = true
+			}
+		}
+	}
+
+	return junctions
+}
+
+// coordinateKey returns a key identifying the exact coordinates of a point.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point.
+//
+// Returns:
+//   - string: The key of the point.
+func coordinateKey(point []float64) string {
+	key := make([]byte, 0, 8*len(point))
+	for _, coordinate := range point {
+		key = binary.LittleEndian.AppendUint64(key, math.Float64bits(coordinate))
+	}
+	return string(key)
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"math"
+	"sort"
+)
+
+// SimplificationIndex stores the significance of every vertex of a polyline, so that it can be
+// simplified with the Douglas-Peucker algorithm at any tolerance without running it again.
+//
+// The significance of a vertex is the largest threshold for which DouglasPeucker keeps it.
+// End points, pinned vertices and corners have an infinite significance, and vertices lying on the
+// simplified line for any threshold are never returned.
+type SimplificationIndex struct {
+	points       [][]float64 // The indexed list of points
+	significance []float64   // Significance of every vertex, by position
+	order        []int       // Positions of the significant vertices, by decreasing significance
+	rank         []int       // Position of every vertex in the order, or the length of the order
+	bounds       int         // Number of vertices always kept, which start the order
+}
+
+// SimplificationIndex runs the Douglas-Peucker algorithm once, down to the last vertex, and
+// records the significance of every vertex. It panics if the input is not valid, see
+// SimplificationIndexE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be indexed.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - *SimplificationIndex: The index of the list of points.
+func (d Decimate) SimplificationIndex(points [][]float64, opts ...Option) *SimplificationIndex {
+	index, err := d.SimplificationIndexE(points, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return index
+}
+
+// SimplificationIndexE runs the Douglas-Peucker algorithm once, down to the last vertex, and
+// records the significance of every vertex.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be indexed.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - *SimplificationIndex: The index of the list of points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) SimplificationIndexE(points [][]float64, opts ...Option) (*SimplificationIndex, error) {
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+
+	config := newOptions(opts)
+	if err := validateDistance(config.distance); err != nil {
+		return nil, err
+	}
+	reduced, positions, bounds, err := d.prefilter(points, config)
+	if err != nil {
+		return nil, err
+	}
+
+	significance := make([]float64, len(reduced))
+	for _, bound := range bounds {
+		significance[bound] = math.Inf(1)
+	}
+
+	order := append([]int(nil), bounds...)
+
+	// Sections are visited in pre-order through an explicit stack, with their distance field
+	// holding the significance of the vertex that created them. Douglas-Peucker only reaches a
+	// vertex if that one is kept, which bounds the significance of the vertex.
+	// Sections between pinned vertices are pushed last to first, so they are visited in order.
+	stack := make([]section, 0, len(bounds)-1)
+	for k := len(bounds) - 1; k > 0; k-- {
+		stack = append(stack, section{first: bounds[k-1], last: bounds[k], distance: math.Inf(1)})
+	}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		index, distance := d.farthestPoint(reduced, current.first, current.last, config)
+		if index == -1 {
+			continue
+		}
+
+		significance[index] = math.Min(distance, current.distance)
+		order = append(order, index)
+
+		stack = append(stack,
+			section{first: index, last: current.last, distance: significance[index]},
+			section{first: current.first, last: index, distance: significance[index]},
+		)
+	}
+
+	// The ranking is built in pre-order, so a stable sort keeps every vertex after the vertex
+	// that created its section when both have the same significance.
+	sort.SliceStable(order, func(i, j int) bool {
+		return significance[order[i]] > significance[order[j]]
+	})
+
+	// Points removed by the pre-filter are never kept, so their significance is zero.
+	if positions != nil {
+		restored := make([]float64, len(points))
+		for i, position := range positions {
+			restored[position] = significance[i]
+		}
+		significance = restored
+		order = restoreIndices(order, positions)
+	}
+
+	// Vertices missing from the order rank after all of them, so they are never kept.
+	rank := make([]int, len(points))
+	for i := range rank {
+		rank[i] = len(order)
+	}
+	for r, position := range order {
+		rank[position] = r
+	}
+
+	return &SimplificationIndex{points: points, significance: significance, order: order, rank: rank, bounds: len(bounds)}, nil
+}
+
+// Significance returns the significance of the vertex at the given position, the largest
+// threshold for which DouglasPeucker keeps it. Vertices never kept have a significance of zero.
+//
+// Parameters:
+//   - position (int): The position of the vertex in the indexed list of points.
+//
+// Returns:
+//   - float64: The significance of the vertex.
+func (s SimplificationIndex) Significance(position int) float64 {
+	return s.significance[position]
+}
+
+// AtIndices returns the positions of the points kept by DouglasPeucker with the given threshold.
+// It panics if the threshold is not valid, see AtIndicesE for the error-returning form.
+//
+// Parameters:
+//   - threshold (float64): The threshold to be used in the simplification.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (s SimplificationIndex) AtIndices(threshold float64) []int {
+	return mustIndices(s.AtIndicesE(threshold))
+}
+
+// AtIndicesE returns the positions of the points kept by DouglasPeucker with the given threshold.
+//
+// Parameters:
+//   - threshold (float64): The threshold to be used in the simplification.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrNegativeThreshold if the threshold is negative or NaN.
+func (s SimplificationIndex) AtIndicesE(threshold float64) ([]int, error) {
+	if err := validateThreshold("threshold", threshold); err != nil {
+		return nil, err
+	}
+	count := sort.Search(len(s.order), func(i int) bool {
+		return s.significance[s.order[i]] < threshold
+	})
+	return s.TopNIndices(count), nil
+}
+
+// At returns the points kept by DouglasPeucker with the given threshold. It panics if the
+// threshold is not valid, see AtE for the error-returning form.
+//
+// Parameters:
+//   - threshold (float64): The threshold to be used in the simplification.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func (s SimplificationIndex) At(threshold float64) [][]float64 {
+	return mustPoints(s.AtE(threshold))
+}
+
+// AtE returns the points kept by DouglasPeucker with the given threshold.
+//
+// Parameters:
+//   - threshold (float64): The threshold to be used in the simplification.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+//   - error: An error wrapping ErrNegativeThreshold if the threshold is negative or NaN.
+func (s SimplificationIndex) AtE(threshold float64) ([][]float64, error) {
+	indices, err := s.AtIndicesE(threshold)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(s.points, indices), nil
+}
+
+// TopNIndices returns the positions of the n most significant points. The end points, and the
+// vertices pinned with WithPinned or WithCornerAngle, are always kept, so values of n lower than
+// their number behave as their number. The rank of every vertex is recorded by the index, so the
+// positions are found in a single pass over the points, without sorting them.
+//
+// Parameters:
+//   - n (int): The maximum number of points to be kept.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (s SimplificationIndex) TopNIndices(n int) []int {
+	n = max(n, s.bounds)
+	n = min(n, len(s.order))
+
+	indices := make([]int, 0, n)
+	for position, rank := range s.rank {
+		if rank < n {
+			indices = append(indices, position)
+		}
+	}
+	return indices
+}
+
+// TopN returns the n most significant points. The end points, and the vertices pinned with
+// WithPinned or WithCornerAngle, are always kept, so values of n lower than their number behave
+// as their number.
+//
+// Parameters:
+//   - n (int): The maximum number of points to be kept.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func (s SimplificationIndex) TopN(n int) [][]float64 {
+	return SelectPoints(s.points, s.TopNIndices(n))
+}
+
+// Len returns the number of significant points, the largest number of points that the index
+// can return.
+//
+// Returns:
+//   - int: The number of significant points.
+func (s SimplificationIndex) Len() int {
+	return len(s.order)
+}
@@ -0,0 +1,192 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+)
+
+// squishPoint is a point held in the buffer of a SquishEStream. Points are linked to their current
+// neighbours in the buffer so that removals can be done in constant time.
+type squishPoint struct {
+	point    []float64    // Coordinates of the point
+	index    int          // Position of the point in the stream
+	error    float64      // Largest priority inherited from the removed neighbours
+	priority float64      // Upper bound of the error made when removing the point
+	previous *squishPoint // Previous point in the buffer, nil if none
+	next     *squishPoint // Next point in the buffer, nil if none
+	heapSlot int          // Position of the point inside the heap, -1 if not queued
+}
+
+// squishHeap is a min-heap of the interior points of the buffer ordered by priority.
+// Ties are broken by the position of the point in the stream so results are deterministic.
+type squishHeap []*squishPoint
+
+func (h squishHeap) Len() int { return len(h) }
+
+func (h squishHeap) Less(i, j int) bool {
+	if h[i].priority == h[j].priority {
+		return h[i].index < h[j].index
+	}
+	return h[i].priority < h[j].priority
+}
+
+func (h squishHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapSlot = i
+	h[j].heapSlot = j
+}
+
+func (h *squishHeap) Push(x any) {
+	point := x.(*squishPoint)
+	point.heapSlot = len(*h)
+	*h = append(*h, point)
+}
+
+func (h *squishHeap) Pop() any {
+	old := *h
+	size := len(old)
+	point := old[size-1]
+	old[size-1] = nil
+	point.heapSlot = -1
+	*h = old[:size-1]
+	return point
+}
+
+// SquishEStream compresses a trajectory as its points arrive with the SQUISH-E algorithm. The
+// points are held in a buffer of fixed capacity, where each interior point has a priority bounding
+// the synchronized Euclidean distance made when removing it. It is built by NewSquishEStream.
+type SquishEStream struct {
+	trajectoryStream
+	capacity int          // Largest number of points held in the buffer
+	maxError float64      // Largest priority of the points removed from a buffer that is not full
+	size     int          // Number of points held in the buffer
+	first    *squishPoint // Oldest point in the buffer, nil if empty
+	latest   *squishPoint // Newest point in the buffer, nil if empty
+	queue    squishHeap   // Interior points of the buffer
+}
+
+// NewSquishEStream builds a stream compressing a trajectory with the SQUISH-E algorithm. Each
+// interior point of the buffer has a priority equal to its synchronized Euclidean distance to the
+// segment joining its neighbours plus the largest priority inherited from the points removed next
+// to it, which bounds the error made by its removal. Points are removed while the lowest priority
+// does not exceed maxError, and whenever the buffer holds more than capacity points the point with
+// the lowest priority is removed whatever its priority, as in SQUISH-E(λ, μ). The buffer is thus
+// the compressed trajectory, of at most capacity points, and it is only emitted by Flush. The
+// geometry must implement interfaces.SynchronizedGeometry.
+//
+// Parameters:
+//   - capacity (int): The largest number of points held in the buffer, at least 3.
+//   - maxError (float64): The largest priority of a point removed while the buffer is not full.
+//
+// Returns:
+//   - *SquishEStream: The stream, which has not received any point.
+//   - error: An error wrapping ErrInvalidPointBudget if capacity is too small, ErrNegativeThreshold
+//     if maxError is not valid, or ErrUnsupportedGeometry if the geometry has no timestamps.
+func (d Decimate) NewSquishEStream(capacity int, maxError float64) (*SquishEStream, error) {
+	if capacity < 3 {
+		return nil, fmt.Errorf("%w: capacity must be at least 3, got %v", ErrInvalidPointBudget, capacity)
+	}
+	if err := validateThreshold("maxError", maxError); err != nil {
+		return nil, err
+	}
+	stream, err := newTrajectoryStream(d)
+	if err != nil {
+		return nil, err
+	}
+	return &SquishEStream{trajectoryStream: stream, capacity: capacity, maxError: maxError}, nil
+}
+
+// Push gives the next point of the trajectory to the stream.
+//
+// Parameters:
+//   - point ([]float64): The point, whose timestamp is given by the geometry.
+//
+// Returns:
+//   - [][]float64: The emitted points, always none since the buffer is only emitted by Flush.
+//   - error: An error wrapping ErrNotMonotonic if the timestamp of the point is before the one of
+//     the previous point, or another sentinel error if the point is not valid.
+func (s *SquishEStream) Push(point []float64) ([][]float64, error) {
+	if err := s.accept(point); err != nil {
+		return nil, err
+	}
+
+	added := &squishPoint{point: point, index: s.count - 1, previous: s.latest, heapSlot: -1}
+	if s.latest == nil {
+		s.first = added
+	} else {
+		s.latest.next = added
+		if s.latest.previous != nil {
+			s.prioritize(s.latest)
+			heap.Push(&s.queue, s.latest)
+		}
+	}
+	s.latest = added
+	s.size++
+
+	for s.queue.Len() > 0 && s.queue[0].priority <= s.maxError {
+		s.remove(heap.Pop(&s.queue).(*squishPoint))
+	}
+	// A full buffer has interior points, since its capacity is at least 3.
+	for s.size > s.capacity {
+		s.remove(heap.Pop(&s.queue).(*squishPoint))
+	}
+	return nil, nil
+}
+
+// Flush emits the points held in the buffer, in order, and empties it. The stream can keep
+// receiving points afterwards, which start a new buffer.
+//
+// Returns:
+//   - [][]float64: The emitted points.
+func (s *SquishEStream) Flush() [][]float64 {
+	emitted := make([][]float64, 0, s.size)
+	for held := s.first; held != nil; held = held.next {
+		emitted = append(emitted, held.point)
+	}
+	// The queue is cleared so its backing array does not retain the flushed points.
+	clear(s.queue)
+	s.first, s.latest, s.size, s.queue = nil, nil, 0, s.queue[:0]
+	return emitted
+}
+
+// prioritize computes the priority of an interior point of the buffer.
+//
+// Parameters:
+//   - held (*squishPoint): The point, which must have both neighbours.
+func (s *SquishEStream) prioritize(held *squishPoint) {
+	held.priority = held.error + s.synchronized.SynchronizedDistanceCoordinates(held.point, held.previous.point, held.next.point)
+}
+
+// remove unlinks a point taken out of the heap from the buffer and updates the priorities of its
+// neighbours, which inherit its priority as part of their error.
+//
+// Parameters:
+//   - removed (*squishPoint): The point, which must be an interior point of the buffer.
+func (s *SquishEStream) remove(removed *squishPoint) {
+	previous, next := removed.previous, removed.next
+	previous.next = next
+	next.previous = previous
+	s.size--
+
+	for _, neighbour := range []*squishPoint{previous, next} {
+		neighbour.error = math.Max(neighbour.error, removed.priority)
+		if neighbour.heapSlot >= 0 {
+			s.prioritize(neighbour)
+			heap.Fix(&s.queue, neighbour.heapSlot)
+		}
+	}
+}
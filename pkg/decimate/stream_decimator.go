@@ -0,0 +1,213 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"context"
+	"fmt"
+)
+
+// WindowMode selects how a StreamDecimator splits the incoming points into windows.
+type WindowMode int
+
+const (
+	// FixedWindow runs Douglas-Peucker over windows with a fixed number of points, each one starting
+	// at a point kept by the previous window.
+	FixedWindow WindowMode = iota
+	// OpeningWindow grows a window from the last emitted point while Douglas-Peucker keeps only its
+	// end points, and emits the previous point when a new one breaks the tolerance.
+	OpeningWindow
+)
+
+// StreamDecimator simplifies the points received over a channel with the Douglas-Peucker
+// algorithm, holding at most a window of points in memory. It is built by NewStreamDecimator and
+// run by Run, and it holds no state between runs.
+type StreamDecimator struct {
+	decimate Decimate   // The decimate operation running Douglas-Peucker
+	epsilon  float64    // Maximum distance from a removed point to the simplified polyline
+	mode     WindowMode // How the points are split into windows
+	window   int        // Largest number of points held in a window
+	overlap  int        // Number of trailing points of a fixed window simplified again by the next
+	opts     []Option   // Options of Douglas-Peucker
+}
+
+// NewStreamDecimator builds a StreamDecimator.
+//
+// With FixedWindow, Douglas-Peucker runs every time window points are received. The kept points
+// are emitted up to the last one before the trailing overlap points, and that point starts the
+// next window, so the points in the overlap are simplified again with the points that follow
+// them. With no overlap, the output is the batch Douglas-Peucker of consecutive windows sharing
+// their end points. If no point is kept before the overlap, the first kept point after it starts
+// the next window.
+//
+// With OpeningWindow, the window grows from the last emitted point while Douglas-Peucker keeps
+// only its end points. When a new point breaks the tolerance, the point before it is emitted and
+// starts a new window, and when the window reaches window points its last point is emitted. The
+// overlap is not used.
+//
+// Parameters:
+//   - epsilon (float64): Maximum distance from a removed point to the simplified polyline.
+//   - mode (WindowMode): How the points are split into windows, FixedWindow or OpeningWindow.
+//   - window (int): Largest number of points held in a window, at least 3.
+//   - overlap (int): Number of trailing points of a fixed window simplified again by the next
+//     one, from 0 to window-2.
+//   - opts (...Option): Options of Douglas-Peucker applied to every window, such as WithDistance.
+//
+// Returns:
+//   - *StreamDecimator: The stream decimator.
+//   - error: An error wrapping ErrNegativeThreshold if epsilon is not valid, ErrInvalidParameter
+//     if mode is unknown, or ErrInvalidWindow if window or overlap are out of range.
+func (d Decimate) NewStreamDecimator(epsilon float64, mode WindowMode, window, overlap int, opts ...Option) (*StreamDecimator, error) {
+	if err := validateThreshold("epsilon", epsilon); err != nil {
+		return nil, err
+	}
+	if mode != FixedWindow && mode != OpeningWindow {
+		return nil, fmt.Errorf("%w: unknown window mode %v", ErrInvalidParameter, mode)
+	}
+	if window < 3 {
+		return nil, fmt.Errorf("%w: window must be at least 3, but it is %v", ErrInvalidWindow, window)
+	}
+	if overlap < 0 || overlap > window-2 {
+		return nil, fmt.Errorf("%w: overlap must be between 0 and %v, but it is %v", ErrInvalidWindow, window-2, overlap)
+	}
+	return &StreamDecimator{decimate: d, epsilon: epsilon, mode: mode, window: window, overlap: overlap, opts: opts}, nil
+}
+
+// Run simplifies the points received from input until it is closed, sending the kept points to
+// output in order. Sends block until output is read, so a slow consumer slows the reading of the
+// input down. Run closes output when it returns.
+//
+// Parameters:
+//   - ctx (context.Context): The context, whose cancellation stops the run.
+//   - input (<-chan []float64): The points to be simplified.
+//   - output (chan<- []float64): The kept points.
+//
+// Returns:
+//   - error: The error of the context if it is cancelled, an error wrapping one of the sentinel
+//     errors if a point is not valid, or nil once input is closed and every kept point is sent.
+func (s *StreamDecimator) Run(ctx context.Context, input <-chan []float64, output chan<- []float64) error {
+	defer close(output)
+
+	send := func(points ...[]float64) error {
+		for _, point := range points {
+			select {
+			case output <- point:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	}
+
+	buffer := make([][]float64, 0, s.window)
+	for count := 0; ; count++ {
+		var point []float64
+		var ok bool
+		select {
+		case point, ok = <-input:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if !ok {
+			emitted, err := s.finish(buffer)
+			if err != nil {
+				return fmt.Errorf("last points: %w", err)
+			}
+			return send(emitted...)
+		}
+		if err := s.decimate.ValidateInputPointList([][]float64{point}); err != nil {
+			return fmt.Errorf("point %v: %w", count, err)
+		}
+
+		buffer = append(buffer, point)
+		emitted, next, err := s.step(buffer)
+		if err != nil {
+			return fmt.Errorf("points up to %v: %w", count, err)
+		}
+		buffer = append(buffer[:0], buffer[next:]...)
+		if err := send(emitted...); err != nil {
+			return err
+		}
+	}
+}
+
+// step simplifies the window after a point is added to it.
+//
+// Parameters:
+//   - buffer ([][]float64): The points of the window, the last one just received.
+//
+// Returns:
+//   - [][]float64: The points to be emitted.
+//   - int: The position in the window of the first point of the next window.
+//   - error: An error wrapping one of the sentinel errors if Douglas-Peucker fails.
+func (s *StreamDecimator) step(buffer [][]float64) ([][]float64, int, error) {
+	size := len(buffer)
+	if s.mode == OpeningWindow {
+		if size == 1 {
+			return [][]float64{buffer[0]}, 0, nil
+		}
+		if size > 2 {
+			indices, err := s.decimate.DouglasPeuckerIndicesE(buffer, s.epsilon, s.opts...)
+			if err != nil {
+				return nil, 0, err
+			}
+			if len(indices) > 2 {
+				return [][]float64{buffer[size-2]}, size - 2, nil
+			}
+		}
+		if size == s.window {
+			return [][]float64{buffer[size-1]}, size - 1, nil
+		}
+		return nil, 0, nil
+	}
+
+	if size < s.window {
+		return nil, 0, nil
+	}
+	indices, err := s.decimate.DouglasPeuckerIndicesE(buffer, s.epsilon, s.opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+	// The last kept point before the overlap starts the next window, or the first kept point
+	// after the start if there is none.
+	last := 1
+	for last+1 < len(indices) && indices[last+1] <= size-1-s.overlap {
+		last++
+	}
+	return SelectPoints(buffer, indices[:last]), indices[last], nil
+}
+
+// finish simplifies the window left when the input is closed.
+//
+// Parameters:
+//   - buffer ([][]float64): The points of the window.
+//
+// Returns:
+//   - [][]float64: The points to be emitted.
+//   - error: An error wrapping one of the sentinel errors if Douglas-Peucker fails.
+func (s *StreamDecimator) finish(buffer [][]float64) ([][]float64, error) {
+	switch {
+	case len(buffer) == 0:
+		return nil, nil
+	case s.mode == OpeningWindow:
+		// The first point of an opening window is already emitted.
+		if len(buffer) == 1 {
+			return nil, nil
+		}
+		return [][]float64{buffer[len(buffer)-1]}, nil
+	case len(buffer) < 3:
+		return buffer, nil
+	}
+	return s.decimate.DouglasPeuckerE(buffer, s.epsilon, s.opts...)
+}
@@ -0,0 +1,185 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import "math"
+
+// SwingingDoorStream compresses a time series with the swinging door trending algorithm as its
+// points arrive, holding a single point in memory. It is built by NewSwingingDoorStream.
+type SwingingDoorStream struct {
+	historian
+	deviation float64 // Compression deviation, half the width of the doors
+	upper     float64 // Largest slope of the upper door since the last archived point
+	lower     float64 // Smallest slope of the lower door since the last archived point
+}
+
+// NewSwingingDoorStream builds a stream compressing a time series with the swinging door trending
+// algorithm, see SwingingDoorIndicesE. Points are given with Push, which returns the points
+// archived as soon as they are known, and Flush archives the last point of the series.
+//
+// Parameters:
+//   - deviation (float64): The compression deviation, half the width of the doors. Every removed
+//     point lies within deviation of a line leaving the archived point before it.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - *SwingingDoorStream: The stream, which has not received any point.
+//   - error: An error wrapping ErrNegativeThreshold if deviation or the maximum interval are not
+//     valid, or ErrUnsupportedDimension if the points of the geometry have no value.
+func (d Decimate) NewSwingingDoorStream(deviation float64, opts ...Option) (*SwingingDoorStream, error) {
+	if err := validateThreshold("deviation", deviation); err != nil {
+		return nil, err
+	}
+	h, err := newHistorian(d, newOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return &SwingingDoorStream{historian: h, deviation: deviation}, nil
+}
+
+// Push gives the next point of the time series to the stream.
+//
+// Parameters:
+//   - point ([]float64): The point, with the time as first coordinate and the value as second one.
+//
+// Returns:
+//   - [][]float64: The points archived after receiving the point, in time order.
+//   - error: An error wrapping ErrNotMonotonic if the time of the point is before the time of the
+//     previous point, or another sentinel error if the point is not valid.
+func (s *SwingingDoorStream) Push(point []float64) ([][]float64, error) {
+	return s.push(point, s.step)
+}
+
+// Flush archives the last point received by the stream, if it is not archived yet. The stream
+// can keep receiving points afterwards.
+//
+// Returns:
+//   - [][]float64: The archived points.
+func (s *SwingingDoorStream) Flush() [][]float64 {
+	s.flush()
+	_, points := s.drain()
+	return points
+}
+
+// step runs the swinging door trending algorithm over the point being pushed.
+//
+// Parameters:
+//   - point ([]float64): The point being pushed.
+func (s *SwingingDoorStream) step(point []float64) {
+	if s.archived == nil {
+		s.archive(s.count, point)
+		s.open()
+		return
+	}
+	if s.expire(point) {
+		s.open()
+	}
+
+	upper := math.Max(s.upper, slope(s.archived, point[0], point[1]-s.deviation))
+	lower := math.Min(s.lower, slope(s.archived, point[0], point[1]+s.deviation))
+	if s.held != nil && upper > lower {
+		s.archive(s.heldIndex, s.held)
+		s.open()
+		upper = slope(s.archived, point[0], point[1]-s.deviation)
+		lower = slope(s.archived, point[0], point[1]+s.deviation)
+	}
+	s.upper, s.lower = upper, lower
+	s.hold(point)
+}
+
+// open resets the doors after a point is archived.
+func (s *SwingingDoorStream) open() {
+	s.upper, s.lower = math.Inf(-1), math.Inf(1)
+}
+
+// SwingingDoor compresses a time series using the swinging door trending algorithm.
+// It panics if the input is not valid, see SwingingDoorE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - deviation (float64): The compression deviation.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - [][]float64: The archived points.
+func (d Decimate) SwingingDoor(points [][]float64, deviation float64, opts ...Option) [][]float64 {
+	return mustPoints(d.SwingingDoorE(points, deviation, opts...))
+}
+
+// SwingingDoorE compresses a time series using the swinging door trending algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - deviation (float64): The compression deviation.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - [][]float64: The archived points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) SwingingDoorE(points [][]float64, deviation float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.SwingingDoorIndicesE(points, deviation, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// SwingingDoorIndices compresses a time series using the swinging door trending algorithm and
+// returns the positions of the archived points in the input list. It panics if the input is not
+// valid, see SwingingDoorIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - deviation (float64): The compression deviation.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - []int: The positions of the archived points, in increasing order.
+func (d Decimate) SwingingDoorIndices(points [][]float64, deviation float64, opts ...Option) []int {
+	return mustIndices(d.SwingingDoorIndicesE(points, deviation, opts...))
+}
+
+// SwingingDoorIndicesE compresses a time series using the swinging door trending algorithm and
+// returns the positions of the archived points in the input list.
+// Two doors pivot on the points deviation above and below the last archived point, and every new
+// point opens them just enough to let it through. When the doors open past parallel, no line
+// from the archived point keeps every point since then within deviation, so the previous point
+// is archived and the doors close on it. The last point is always archived, and WithMaxInterval
+// archives the previous point whenever a point arrives later than the given interval after the
+// last archived one. The result is the one of a SwingingDoorStream given every point and flushed.
+//
+// Parameters:
+//   - points ([][]float64): The time series, with the time as first coordinate and the value as
+//     second one.
+//   - deviation (float64): The compression deviation, half the width of the doors. Every removed
+//     point lies within deviation of a line leaving the archived point before it.
+//   - opts (...Option): Options of the algorithm, such as WithMaxInterval.
+//
+// Returns:
+//   - []int: The positions of the archived points, in increasing order.
+//   - error: An error wrapping ErrNotMonotonic if the time decreases, or another sentinel error if
+//     the input is not valid.
+func (d Decimate) SwingingDoorIndicesE(points [][]float64, deviation float64, opts ...Option) ([]int, error) {
+	if err := d.validateSeries(points); err != nil {
+		return nil, err
+	}
+	stream, err := d.NewSwingingDoorStream(deviation, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return stream.run(points, stream.step), nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"fmt"
+	"github.com/cenieto/decimate/pkg/interfaces"
+)
+
+// TDTR simplifies a trajectory using the top-down time-ratio algorithm.
+// It panics if the input is not valid, see TDTRE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The trajectory to be simplified, whose points carry a timestamp.
+//   - threshold (float64): The largest synchronized Euclidean distance of a removed point.
+//   - opts (...Option): Options of the algorithm, such as WithPinned.
+//
+// Returns:
+//   - [][]float64: The simplified trajectory.
+func (d Decimate) TDTR(points [][]float64, threshold float64, opts ...Option) [][]float64 {
+	return mustPoints(d.TDTRE(points, threshold, opts...))
+}
+
+// TDTRE simplifies a trajectory using the top-down time-ratio algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The trajectory to be simplified, whose points carry a timestamp.
+//   - threshold (float64): The largest synchronized Euclidean distance of a removed point.
+//   - opts (...Option): Options of the algorithm, such as WithPinned.
+//
+// Returns:
+//   - [][]float64: The simplified trajectory.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) TDTRE(points [][]float64, threshold float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.TDTRIndicesE(points, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// TDTRIndices simplifies a trajectory using the top-down time-ratio algorithm and returns the
+// positions of the kept points in the input list. It panics if the input is not valid, see
+// TDTRIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The trajectory to be simplified, whose points carry a timestamp.
+//   - threshold (float64): The largest synchronized Euclidean distance of a removed point.
+//   - opts (...Option): Options of the algorithm, such as WithPinned.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) TDTRIndices(points [][]float64, threshold float64, opts ...Option) []int {
+	return mustIndices(d.TDTRIndicesE(points, threshold, opts...))
+}
+
+// TDTRIndicesE simplifies a trajectory using the top-down time-ratio algorithm and returns the
+// positions of the kept points in the input list.
+// It is the Douglas-Peucker recursion where the deviation of a point is its synchronized Euclidean
+// distance: the distance to the position reached at its timestamp when moving along the
+// simplified segment at constant speed. A vehicle that stops keeps moving along the segment in
+// the simplified trajectory, so its stop is kept even when it lies on the segment. The geometry
+// must implement interfaces.SynchronizedGeometry, as trajectory.Euclid does, and WithDistance is
+// ignored.
+//
+// Parameters:
+//   - points ([][]float64): The trajectory to be simplified, whose points carry a timestamp.
+//   - threshold (float64): The largest synchronized Euclidean distance of a removed point.
+//   - opts (...Option): Options of the algorithm, such as WithPinned.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping ErrUnsupportedGeometry if the geometry has no timestamps,
+//     ErrNotMonotonic if the timestamps decrease, or another sentinel error if the input is not
+//     valid.
+func (d Decimate) TDTRIndicesE(points [][]float64, threshold float64, opts ...Option) ([]int, error) {
+	synchronized, ok := d.Geometry.(interfaces.SynchronizedGeometry)
+	if !ok {
+		return nil, fmt.Errorf("%w: TDTR needs a geometry implementing interfaces.SynchronizedGeometry, such as trajectory.Euclid", ErrUnsupportedGeometry)
+	}
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(points); i++ {
+		if previous, current := synchronized.Time(points[i-1]), synchronized.Time(points[i]); current < previous {
+			return nil, fmt.Errorf("%w: timestamp decreases from %v to %v at position %v", ErrNotMonotonic, previous, current, i)
+		}
+	}
+
+	opts = append(opts[:len(opts):len(opts)], withSynchronized(synchronized))
+	return d.DouglasPeuckerIndicesE(points, threshold, opts...)
+}
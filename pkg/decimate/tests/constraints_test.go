@@ -0,0 +1,174 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geodesic"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"math"
+	"testing"
+)
+
+// notchedOutline returns two sides of a building footprint sampled every unit, with a notch
+// shallower than the thresholds of pinnedAlgorithms in the middle of its first side.
+//
+// Returns:
+//   - [][]float64: The points of the outline.
+//   - []int: The positions of the corners of the outline.
+func notchedOutline() ([][]float64, []int) {
+	var points [][]float64
+	for x := 0; x <= 9; x++ {
+		points = append(points, []float64{float64(x), 0})
+	}
+	points = append(points, []float64{9, 0.3}, []float64{10, 0.3}, []float64{11, 0.3})
+	for x := 11; x <= 20; x++ {
+		points = append(points, []float64{float64(x), 0})
+	}
+	for y := 1; y <= 10; y++ {
+		points = append(points, []float64{20, float64(y)})
+	}
+	return points, []int{9, 10, 12, 13, 22}
+}
+
+// TestCornerAngle tests every algorithm accepting WithCornerAngle.
+// It checks that the corners of a notch lying within the threshold are dropped by default, and
+// kept when their turning angle exceeds the given one.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestCornerAngle(t *testing.T) {
+	points, corners := notchedOutline()
+	d := *geom2d.NewEuclid().Decimate
+
+	indices, err := d.DouglasPeuckerIndicesE(points, 0.5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := []int{0, 22, len(points) - 1}; !equalIndices(indices, expected) {
+		t.Fatalf("DouglasPeuckerIndicesE() = %v; want %v", indices, expected)
+	}
+
+	for _, tt := range pinnedAlgorithms {
+		t.Run(tt.name, func(t *testing.T) {
+			indices, err := tt.indices(d, points, decimate.WithCornerAngle(math.Pi/4))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			kept := make(map[int]bool)
+			for _, index := range indices {
+				kept[index] = true
+			}
+			for _, corner := range corners {
+				if !kept[corner] {
+					t.Errorf("Indices = %v; want corner %v", indices, corner)
+				}
+			}
+		})
+	}
+
+	index := d.SimplificationIndex(points, decimate.WithCornerAngle(math.Pi/4))
+	if result := index.AtIndices(0.5); !equalIndices(result, []int{0, 9, 10, 12, 13, 22, len(points) - 1}) {
+		t.Errorf("AtIndices() = %v; want the end points and the corners %v", result, corners)
+	}
+}
+
+// TestMaxSegmentLength tests every algorithm accepting WithMaxSegmentLength.
+// It checks that no segment of a simplified straight run is longer than the given length.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestMaxSegmentLength(t *testing.T) {
+	points := make([][]float64, 41)
+	for i := range points {
+		points[i] = []float64{float64(i) * 0.5, 0}
+	}
+	d := *geom2d.NewEuclid().Decimate
+	option := decimate.WithMaxSegmentLength(3.0)
+
+	expected := []int{0, 6, 12, 18, 24, 30, 36, 40}
+	if indices := d.DouglasPeuckerIndices(points, 0.5, option); !equalIndices(indices, expected) {
+		t.Errorf("DouglasPeuckerIndices() = %v; want %v", indices, expected)
+	}
+	if indices := d.DouglasPeuckerNIndices(points, 2, option); !equalIndices(indices, expected) {
+		t.Errorf("DouglasPeuckerNIndices() = %v; want %v", indices, expected)
+	}
+
+	for _, tt := range pinnedAlgorithms {
+		t.Run(tt.name, func(t *testing.T) {
+			indices, err := tt.indices(d, points, option)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for k := 1; k < len(indices); k++ {
+				if length := points[indices[k]][0] - points[indices[k-1]][0]; length > 3.0 {
+					t.Errorf("Segment from %v to %v has length %v", indices[k-1], indices[k], length)
+				}
+			}
+		})
+	}
+}
+
+// TestConstraintsInvalid tests every algorithm accepting WithCornerAngle and WithMaxSegmentLength
+// with invalid values. It checks that ErrNegativeThreshold is returned.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestConstraintsInvalid(t *testing.T) {
+	points := wavyTrack(10)
+	d := *geom2d.NewEuclid().Decimate
+	options := []decimate.Option{decimate.WithCornerAngle(-1), decimate.WithMaxSegmentLength(math.NaN())}
+
+	for _, tt := range pinnedAlgorithms {
+		for _, option := range options {
+			if _, err := tt.indices(d, points, option); !errors.Is(err, decimate.ErrNegativeThreshold) {
+				t.Errorf("%v: error = %v; want %v", tt.name, err, decimate.ErrNegativeThreshold)
+			}
+		}
+	}
+}
+
+// TestCornerAngleGeodesic tests the WithCornerAngle option with the sphere geometry.
+// It checks that the turning angle is measured on the sphere: a parallel crossing the
+// antimeridian is not a corner although its longitudes jump by 360 degrees, while a right-angled
+// turn is still kept.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestCornerAngleGeodesic(t *testing.T) {
+	d := *geodesic.NewSphere(geodesic.MeanEarthRadius).Decimate
+
+	crossing := [][]float64{{179.8, 0}, {179.9, 0}, {-180, 0}, {-179.9, 0}, {-179.8, 0}}
+	if indices := d.DouglasPeuckerIndices(crossing, 1.0, decimate.WithCornerAngle(math.Pi/4)); !equalIndices(indices, []int{0, 4}) {
+		t.Errorf("DouglasPeuckerIndices() = %v; want %v", indices, []int{0, 4})
+	}
+
+	turn := [][]float64{{10, 0}, {10.1, 0}, {10.2, 0}, {10.2, 0.1}, {10.2, 0.2}}
+	if indices := d.DouglasPeuckerIndices(turn, 1e5, decimate.WithCornerAngle(math.Pi/4)); !equalIndices(indices, []int{0, 2, 4}) {
+		t.Errorf("DouglasPeuckerIndices() = %v; want %v", indices, []int{0, 2, 4})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"github.com/cenieto/decimate/pkg/testutils"
+	"github.com/cenieto/decimate/pkg/trajectory"
+	"testing"
+)
+
+// TestDeadReckoningStop tests the DeadReckoningStream with a vehicle stopping on a straight road.
+// It checks that a point is emitted when the vehicle stops and starts again, which is where the
+// prediction from the last emitted velocity fails, and that the flush keeps the last point.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDeadReckoningStop(t *testing.T) {
+	geometry := trajectory.NewEuclid(2)
+	stream, err := geometry.Decimate.NewDeadReckoningStream(0.5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The first point has no velocity, so the second one is emitted to give it.
+	expected := []int{0, 1, 51, 61, 110}
+	if times := timestamps(pushAll(t, stream, stopTrack())); !equalIndices(times, expected) {
+		t.Errorf("DeadReckoningStream emitted %v; want %v", times, expected)
+	}
+	if emitted := stream.Flush(); len(emitted) != 0 {
+		t.Errorf("Flush() = %v after a flush; want no points", emitted)
+	}
+}
+
+// TestDeadReckoningRepeatedTime tests the DeadReckoningStream with a point emitted at the
+// timestamp of the point before it, which gives no velocity.
+// It checks that the object is then predicted to stay still at the emitted point, instead of
+// going back to the position of the point before it.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDeadReckoningRepeatedTime(t *testing.T) {
+	geometry := trajectory.NewEuclid(2)
+	stream, err := geometry.Decimate.NewDeadReckoningStream(0.5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	points := [][]float64{{0, 0, 0}, {5, 0, 1}, {6, 0, 1}, {6, 0, 2}, {6, 0, 3}}
+	expected := []int{0, 1, 1, 3}
+	if times := timestamps(pushAll(t, stream, points)); !equalIndices(times, expected) {
+		t.Errorf("DeadReckoningStream emitted %v; want %v", times, expected)
+	}
+}
+
+// TestDeadReckoningFixtures tests the DeadReckoningStream against the fixtures stored in the
+// testdata/dead_reckoning folder, a vehicle trajectory alternating speeds and stops.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDeadReckoningFixtures(t *testing.T) {
+	data, err := testutils.JSONTestDataReader("../../../testdata/dead_reckoning/vehicle_stops.json")
+	if err != nil {
+		t.Fatalf("Error while opening JSON file: %v", err)
+	}
+
+	geometry := trajectory.NewEuclid(2)
+	for _, test := range data.Expected {
+		stream, err := geometry.Decimate.NewDeadReckoningStream(test.Epsilon)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		result, error := testutils.CompareSlices(pushAll(t, stream, data.Input), test.Data)
+		if !result {
+			t.Errorf("The test failed with epsilon %v, %v", test.Epsilon, error)
+		}
+	}
+}
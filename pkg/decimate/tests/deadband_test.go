@@ -0,0 +1,73 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"testing"
+)
+
+// TestDeadbandSteps tests the DeadbandIndices function.
+// It checks that a change of value larger than the deadband archives the point before it along
+// with the point itself, and that small changes are not archived.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDeadbandSteps(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		name     string
+		points   [][]float64
+		expected []int
+	}{
+		{"Flat", [][]float64{{0, 1}, {1, 1.2}, {2, 0.9}, {3, 1.1}}, []int{0, 3}},
+		{"Step", [][]float64{{0, 1}, {1, 1.2}, {2, 0.9}, {3, 5}, {4, 5.1}, {5, 4.9}}, []int{0, 2, 3, 5}},
+		{"ImmediateStep", [][]float64{{0, 1}, {1, 5}, {2, 5}}, []int{0, 1, 2}},
+		{"Drift", [][]float64{{0, 0}, {1, 0.2}, {2, 0.4}, {3, 0.6}, {4, 0.8}, {5, 1.0}}, []int{0, 2, 3, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if indices := geometry.Decimate.DeadbandIndices(tt.points, 0.5); !equalIndices(indices, tt.expected) {
+				t.Errorf("DeadbandIndices() = %v; want %v", indices, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDeadbandMaxInterval tests the DeadbandIndices function with a maximum interval.
+// It checks that a flat series is archived at least once every interval.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDeadbandMaxInterval(t *testing.T) {
+	points := make([][]float64, 11)
+	for i := range points {
+		points[i] = []float64{float64(i), 3}
+	}
+	geometry := geom2d.NewEuclid()
+
+	expected := []int{0, 4, 8, 10}
+	if indices := geometry.Decimate.DeadbandIndices(points, 0.5, decimate.WithMaxInterval(4)); !equalIndices(indices, expected) {
+		t.Errorf("DeadbandIndices() = %v; want %v", indices, expected)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestDouglasPeuckerDegenerate tests the DouglasPeuckerIndices, DouglasPeuckerNIndices and
+// SimplificationIndex functions with closed rings, round trips, repeated points and identical points.
+// It checks that sections whose end points coincide are split at their farthest vertex instead of
+// being dropped.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerDegenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		points    [][]float64
+		threshold float64
+		expected  []int
+		maxPoints int
+		expectedN []int
+	}{
+		{
+			"Ring",
+			[][]float64{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}, {0, 0}},
+			0.5, []int{0, 2, 4, 6, 8},
+			3, []int{0, 4, 8},
+		},
+		{
+			"RoundTrip",
+			[][]float64{{0, 0}, {1, 0}, {2, 0}, {1, 0}, {0, 0}},
+			0.5, []int{0, 2, 4},
+			10, []int{0, 2, 4},
+		},
+		{
+			"RepeatedPoints",
+			[][]float64{{0, 0}, {0, 0}, {1, 1}, {1, 1}, {2, 0}, {2, 0}},
+			0.5, []int{0, 2, 5},
+			10, []int{0, 2, 5},
+		},
+		{
+			"IdenticalPoints",
+			[][]float64{{3, 3}, {3, 3}, {3, 3}, {3, 3}, {3, 3}},
+			0.0, []int{0, 4},
+			10, []int{0, 4},
+		},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices := geometry.Decimate.DouglasPeuckerIndices(tt.points, tt.threshold)
+			if !reflect.DeepEqual(indices, tt.expected) {
+				t.Errorf("DouglasPeuckerIndices(%v) = %v; want %v", tt.threshold, indices, tt.expected)
+			}
+
+			indices = geometry.Decimate.DouglasPeuckerNIndices(tt.points, tt.maxPoints)
+			if !reflect.DeepEqual(indices, tt.expectedN) {
+				t.Errorf("DouglasPeuckerNIndices(%v) = %v; want %v", tt.maxPoints, indices, tt.expectedN)
+			}
+
+			index := geometry.Decimate.SimplificationIndex(tt.points)
+			indices = index.AtIndices(tt.threshold)
+			if !reflect.DeepEqual(indices, tt.expected) {
+				t.Errorf("AtIndices(%v) = %v; want %v", tt.threshold, indices, tt.expected)
+			}
+			for i := range tt.points {
+				if math.IsNaN(index.Significance(i)) {
+					t.Errorf("Significance(%v) is NaN", i)
+				}
+			}
+		})
+	}
+}
+
+// TestDouglasPeuckerClosedRing3D tests the DouglasPeucker function with a closed ring in 3D.
+// It checks that the ring keeps its shape with both distance modes.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerClosedRing3D(t *testing.T) {
+	var points [][]float64
+	for i := 0; i <= 40; i++ {
+		angle := 2 * math.Pi * float64(i) / 40
+		points = append(points, []float64{math.Cos(angle), math.Sin(angle), 0.1 * math.Sin(2*angle)})
+	}
+	// Close the ring exactly, the cosine and sine of 2π are not exactly those of zero.
+	points[len(points)-1] = points[0]
+
+	geometry := geom3d.NewEuclid()
+
+	for _, mode := range []decimate.DistanceMode{decimate.LineDistance, decimate.SegmentDistance} {
+		indices := geometry.Decimate.DouglasPeuckerIndices(points, 0.1, decimate.WithDistance(mode))
+		if len(indices) < 5 {
+			t.Errorf("DouglasPeuckerIndices() with distance mode %v = %v; want the ring shape to be kept", mode, indices)
+		}
+		if indices[0] != 0 || indices[len(indices)-1] != len(points)-1 {
+			t.Errorf("DouglasPeuckerIndices() with distance mode %v = %v; want the end points to be kept", mode, indices)
+		}
+	}
+}
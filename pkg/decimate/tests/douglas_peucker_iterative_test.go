@@ -0,0 +1,216 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/interfaces"
+	"github.com/cenieto/decimate/pkg/primitives"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"math"
+	"testing"
+)
+
+// recursiveDouglasPeucker is the original recursive implementation of the Douglas-Peucker
+// algorithm, kept as a reference for the iterative one.
+//
+// Parameters:
+//   - geometry (interfaces.Geometry): The geometry used to compute distances.
+//   - points ([][]float64): The list of points to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func recursiveDouglasPeucker(geometry interfaces.Geometry, points [][]float64, threshold float64) [][]float64 {
+
+	if len(points) == 2 {
+		return points
+	}
+
+	var distance float64
+	distance_maximum := 0.0
+
+	size_points := len(points)
+	point_1 := primitives.NewPoint(points[0])
+	point_2 := primitives.NewPoint(points[size_points-1])
+	line := primitives.NewLine(point_1, point_2)
+
+	var index int
+	for i := range points {
+		if i != 0 && i != size_points-1 {
+			point_0 := primitives.NewPoint(points[i])
+			distance = geometry.DoubleAreaTriangle(point_0, line)
+			if distance > distance_maximum {
+				distance_maximum = distance
+				index = i
+			}
+		}
+	}
+
+	point_0 := primitives.NewPoint(points[index])
+	distance_maximum = geometry.DistancePointLine(point_0, line)
+
+	if index == 0 || distance_maximum < threshold {
+		return [][]float64{points[0], points[size_points-1]}
+	}
+	left := recursiveDouglasPeucker(geometry, points[:index+1], threshold)
+	right := recursiveDouglasPeucker(geometry, points[index:], threshold)
+	return append(left[:len(left)-1], right...)
+
+}
+
+// spiral generates a planar spiral, an adversarial input for the Douglas-Peucker algorithm
+// because every split only removes a few points from the section.
+//
+// Parameters:
+//   - size (int): The number of points of the spiral.
+//
+// Returns:
+//   - [][]float64: The generated list of points.
+func spiral(size int) [][]float64 {
+	points := make([][]float64, size)
+	for i := range points {
+		angle := 0.05 * float64(i)
+		radius := 1.0 + 0.01*float64(i)
+		points[i] = []float64{radius * math.Cos(angle), radius * math.Sin(angle)}
+	}
+	return points
+}
+
+// TestDouglasPeuckerIterativeMatchesRecursive tests the DouglasPeucker function.
+// It checks that the iterative implementation gives exactly the same output as the recursive one.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerIterativeMatchesRecursive(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   [][]float64
+		geometry interfaces.Geometry
+		decimate *decimate.Decimate
+	}{
+		{"RandomWalk2D", randomWalk(3, 2000, 2), geom2d.Euclid2D{}, geom2d.NewEuclid().Decimate},
+		{"RandomWalk3D", randomWalk(4, 2000, 3), geom3d.Euclid3D{}, geom3d.NewEuclid().Decimate},
+		{"Spiral", spiral(2000), geom2d.Euclid2D{}, geom2d.NewEuclid().Decimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, threshold := range []float64{0.0, 0.01, 0.5, 3.0, 20.0} {
+				// The recursive implementation overwrites its input while merging sections.
+				inputCopy := make([][]float64, len(tt.points))
+				copy(inputCopy, tt.points)
+				expected := recursiveDouglasPeucker(tt.geometry, inputCopy, threshold)
+				points := tt.decimate.DouglasPeucker(tt.points, threshold)
+				result, error := testutils.CompareSlices(points, expected)
+				if !result {
+					t.Errorf("The test failed with epsilon %v, %v", threshold, error)
+				}
+			}
+		})
+	}
+}
+
+// TestDouglasPeuckerDoesNotModifyInput tests the DouglasPeucker function.
+// It checks that the input list of points is left untouched.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerDoesNotModifyInput(t *testing.T) {
+	points := randomWalk(7, 200, 2)
+	expected := make([][]float64, len(points))
+	copy(expected, points)
+
+	geom2d.NewEuclid().Decimate.DouglasPeucker(points, 0.5)
+
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The input was modified, %v", error)
+	}
+}
+
+// TestDouglasPeuckerAllocations tests the DouglasPeuckerIndices function.
+// It checks that the number of allocations does not grow with the number of points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerAllocations(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	small := randomWalk(5, 1000, 2)
+	large := randomWalk(5, 100000, 2)
+
+	allocationsSmall := testing.AllocsPerRun(5, func() {
+		geometry.Decimate.DouglasPeuckerIndices(small, 1.0)
+	})
+	allocationsLarge := testing.AllocsPerRun(5, func() {
+		geometry.Decimate.DouglasPeuckerIndices(large, 1.0)
+	})
+
+	// Only the growth of the section stack depends on the input, logarithmically.
+	if allocationsLarge > allocationsSmall+10 {
+		t.Errorf("DouglasPeuckerIndices allocated %v times for %v points and %v times for %v points", allocationsSmall, len(small), allocationsLarge, len(large))
+	}
+}
+
+// BenchmarkDouglasPeuckerRecursive measures the original recursive implementation on a random walk.
+func BenchmarkDouglasPeuckerRecursive(b *testing.B) {
+	points := randomWalk(6, 100000, 2)
+	inputCopy := make([][]float64, len(points))
+	for i := 0; i < b.N; i++ {
+		copy(inputCopy, points)
+		recursiveDouglasPeucker(geom2d.Euclid2D{}, inputCopy, 1.0)
+	}
+}
+
+// BenchmarkDouglasPeuckerIterative measures the iterative implementation on a random walk.
+func BenchmarkDouglasPeuckerIterative(b *testing.B) {
+	points := randomWalk(6, 100000, 2)
+	geometry := geom2d.NewEuclid()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		geometry.Decimate.DouglasPeucker(points, 1.0)
+	}
+}
+
+// BenchmarkDouglasPeuckerRecursiveSpiral measures the original recursive implementation on a spiral.
+func BenchmarkDouglasPeuckerRecursiveSpiral(b *testing.B) {
+	points := spiral(5000)
+	inputCopy := make([][]float64, len(points))
+	for i := 0; i < b.N; i++ {
+		copy(inputCopy, points)
+		recursiveDouglasPeucker(geom2d.Euclid2D{}, inputCopy, 0.001)
+	}
+}
+
+// BenchmarkDouglasPeuckerIterativeSpiral measures the iterative implementation on a spiral.
+func BenchmarkDouglasPeuckerIterativeSpiral(b *testing.B) {
+	points := spiral(5000)
+	geometry := geom2d.NewEuclid()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		geometry.Decimate.DouglasPeucker(points, 0.001)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"reflect"
+	"testing"
+)
+
+// TestDouglasPeuckerNInvalidBudget tests the DouglasPeuckerNE function.
+// It checks that a budget lower than two points returns ErrInvalidPointBudget.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerNInvalidBudget(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 1.0},
+		{2.0, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	_, err := geometry.Decimate.DouglasPeuckerNE(points, 1)
+	if !errors.Is(err, decimate.ErrInvalidPointBudget) {
+		t.Errorf("DouglasPeuckerNE returned error %v, want %v", err, decimate.ErrInvalidPointBudget)
+	}
+}
+
+// TestDouglasPeuckerNMostSignificantPoints tests the DouglasPeuckerNIndices function.
+// It checks that the points are added in decreasing order of deviation until the budget is reached,
+// and that collinear points are never added.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerNMostSignificantPoints(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.5},
+		{2.0, 0.0},
+		{3.0, 3.0},
+		{4.0, 0.0},
+		{5.0, 0.0},
+		{6.0, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		maxPoints int
+		expected  []int
+	}{
+		{2, []int{0, 6}},
+		{3, []int{0, 3, 6}},
+		{4, []int{0, 2, 3, 6}},
+		{5, []int{0, 2, 3, 4, 6}},
+		{6, []int{0, 1, 2, 3, 4, 6}},
+		{100, []int{0, 1, 2, 3, 4, 6}},
+	}
+
+	for _, test := range tests {
+		indices := geometry.Decimate.DouglasPeuckerNIndices(points, test.maxPoints)
+		if !reflect.DeepEqual(indices, test.expected) {
+			t.Errorf("DouglasPeuckerNIndices(points, %v) = %v; want %v", test.maxPoints, indices, test.expected)
+		}
+	}
+}
+
+// TestDouglasPeuckerNFixtures tests the DouglasPeuckerN function against the fixtures stored in
+// the testdata/douglas_peucker_n folder, where the epsilon field holds the maximum number of points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerNFixtures(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		decimate *decimate.Decimate
+	}{
+		{"SingleLine2DNoise", "single_line_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DNoise", "polyline_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline3DNoise", "polyline_3d_noise.json", geom3d.NewEuclid().Decimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/douglas_peucker_n/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.DouglasPeuckerN(data.Input, int(test.Epsilon))
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with %v points, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+				}
+			}
+		})
+	}
+}
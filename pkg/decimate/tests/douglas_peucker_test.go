@@ -14,8 +14,11 @@
 package tests
 
 import (
+	"fmt"
+	"github.com/cenieto/decimate/pkg/decimate"
 	"github.com/cenieto/decimate/pkg/geom2d"
 	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/geomnd"
 	"github.com/cenieto/decimate/pkg/testutils"
 	"testing"
 )
@@ -279,3 +282,104 @@ func TestDouglasPeucker3DPolyineRandomOffset(t *testing.T) {
 
 	}
 }
+
+// TestDouglasPeuckerNDPolyineRandomOffset tests the DouglasPeucker function with the geomnd geometry.
+// It checks that the 2D and 3D fixtures give the same results as with geom2d and geom3d, and that
+// a 4D polyline is simplified.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerNDPolyineRandomOffset(t *testing.T) {
+	tests := []struct {
+		dimension   int
+		fixtureFile string
+	}{
+		{2, "../../../testdata/douglas_peucker/polyline_2d_noise.json"},
+		{3, "../../../testdata/douglas_peucker/polyline_3d_noise.json"},
+		{4, "../../../testdata/douglas_peucker/polyline_4d_noise.json"},
+	}
+
+	for _, tt := range tests {
+		data, err := testutils.JSONTestDataReader(tt.fixtureFile)
+		if err != nil {
+			t.Fatalf("Error while opening JSON file: %v", err)
+		}
+
+		geometry := geomnd.NewEuclid(tt.dimension)
+
+		for _, test := range data.Expected {
+			points := geometry.Decimate.DouglasPeucker(data.Input, test.Epsilon)
+			result, error := testutils.CompareSlices(points, test.Data)
+			if !result {
+				t.Errorf("The test failed in %vD with epsilon %v, %v, expected: %v\n, result: %v", tt.dimension, test.Epsilon, error, test.Data, points)
+			}
+		}
+	}
+}
+
+// TestDouglasPeuckerSegmentDistance tests the DouglasPeucker function with the WithDistance option.
+// It checks that the turning point of a track that doubles back on itself is dropped when measuring
+// the distance to the line, and kept when measuring the distance to the segment.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerSegmentDistance(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{5.0, 0.1},
+		{10.0, 0.0},
+		{6.0, 0.1},
+		{2.0, 0.0},
+	}
+
+	tests := []struct {
+		name     string
+		mode     decimate.DistanceMode
+		expected [][]float64
+	}{
+		{"LineDistance", decimate.LineDistance, [][]float64{{0.0, 0.0}, {2.0, 0.0}}},
+		{"SegmentDistance", decimate.SegmentDistance, [][]float64{{0.0, 0.0}, {10.0, 0.0}, {2.0, 0.0}}},
+	}
+
+	for _, dimension := range []int{2, 3} {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%dD/%s", dimension, tt.name), func(t *testing.T) {
+				input := points
+				expected := tt.expected
+				d := geom2d.NewEuclid().Decimate
+				if dimension == 3 {
+					input = appendCoordinate(points, 1.0)
+					expected = appendCoordinate(tt.expected, 1.0)
+					d = geom3d.NewEuclid().Decimate
+				}
+
+				result, error := testutils.CompareSlices(d.DouglasPeucker(input, 0.5, decimate.WithDistance(tt.mode)), expected)
+				if !result {
+					t.Errorf("The test failed in %vD, %v", dimension, error)
+				}
+			})
+		}
+	}
+}
+
+// appendCoordinate returns a copy of a list of points with an extra coordinate appended to each point.
+//
+// Parameters:
+//   - points ([][]float64): The list of points.
+//   - value (float64): The value of the extra coordinate.
+//
+// Returns:
+//   - [][]float64: The list of points with one more dimension.
+func appendCoordinate(points [][]float64, value float64) [][]float64 {
+	result := make([][]float64, len(points))
+	for i, point := range points {
+		result[i] = append(append([]float64(nil), point...), value)
+	}
+	return result
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"math"
+	"testing"
+)
+
+// TestDouglasPeuckerESentinelErrors tests the DouglasPeuckerE and VisvalingamWhyattE functions.
+// It checks that every kind of invalid input returns the matching sentinel error.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerESentinelErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		points    [][]float64
+		threshold float64
+		expected  error
+	}{
+		{"Empty", [][]float64{}, 0.1, decimate.ErrTooFewPoints},
+		{"OnePoint", [][]float64{{1.0, 2.0}}, 0.1, decimate.ErrTooFewPoints},
+		{"DifferentDimensions", [][]float64{{1.0, 2.0}, {1.0, 2.0, 3.0}}, 0.1, decimate.ErrDimensionMismatch},
+		{"NaNCoordinate", [][]float64{{1.0, 2.0}, {math.NaN(), 2.0}}, 0.1, decimate.ErrNonFiniteCoordinate},
+		{"InfCoordinate", [][]float64{{1.0, math.Inf(-1)}, {1.0, 2.0}}, 0.1, decimate.ErrNonFiniteCoordinate},
+		{"NegativeThreshold", [][]float64{{1.0, 2.0}, {1.0, 2.0}}, -0.1, decimate.ErrNegativeThreshold},
+		{"NaNThreshold", [][]float64{{1.0, 2.0}, {1.0, 2.0}}, math.NaN(), decimate.ErrNegativeThreshold},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := geometry.Decimate.DouglasPeuckerE(tt.points, tt.threshold)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("DouglasPeuckerE returned error %v, want %v", err, tt.expected)
+			}
+			if points != nil {
+				t.Errorf("DouglasPeuckerE returned points %v together with an error", points)
+			}
+
+			_, err = geometry.Decimate.VisvalingamWhyattE(tt.points, tt.threshold)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("VisvalingamWhyattE returned error %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDouglasPeuckerPanicsOnInvalidInput tests the DouglasPeucker function.
+// It checks that the panicking form panics with the sentinel error when the input is not valid.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerPanicsOnInvalidInput(t *testing.T) {
+	points := [][]float64{
+		{1.0, 2.0},
+		{1.0, 2.0, 3.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, decimate.ErrDimensionMismatch) {
+			t.Errorf("DouglasPeucker panicked with %v, want %v", r, decimate.ErrDimensionMismatch)
+		}
+	}()
+
+	geometry.Decimate.DouglasPeucker(points, 0.1)
+}
+
+// TestDouglasPeuckerEZeroThreshold tests the DouglasPeuckerE function.
+// It checks that a zero threshold keeps every deviating vertex and drops the collinear ones.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerEZeroThreshold(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.0},
+		{2.0, 0.0},
+		{3.0, 1.0},
+		{4.0, 0.0},
+	}
+	expected := [][]float64{
+		{0.0, 0.0},
+		{2.0, 0.0},
+		{3.0, 1.0},
+		{4.0, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	points, err := geometry.Decimate.DouglasPeuckerE(points, 0.0)
+	if err != nil {
+		t.Fatalf("DouglasPeuckerE returned unexpected error %v", err)
+	}
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
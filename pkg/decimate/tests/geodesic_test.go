@@ -0,0 +1,66 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geodesic"
+	"reflect"
+	"testing"
+)
+
+// TestDouglasPeuckerGeodesic tests the DouglasPeucker function with the geodesic geometries.
+// It checks that the threshold is a distance in meters across the antimeridian and over a pole,
+// where longitudes and latitudes are far from planar coordinates.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerGeodesic(t *testing.T) {
+	tracks := []struct {
+		name   string
+		points [][]float64
+	}{
+		// The second point is about 11 meters north of the equator.
+		{"Antimeridian", [][]float64{{179.999, 0}, {180, 0.0001}, {-179.999, 0}}},
+		// The second point is about 11 meters away from the pole, perpendicular to the track.
+		{"Pole", [][]float64{{30, 89.999}, {120, 89.9999}, {-150, 89.999}}},
+	}
+
+	geometries := []struct {
+		name     string
+		decimate *decimate.Decimate
+	}{
+		{"Sphere", geodesic.NewSphere(geodesic.MeanEarthRadius).Decimate},
+		{"WGS84", geodesic.NewWGS84().Decimate},
+	}
+
+	for _, geometry := range geometries {
+		for _, track := range tracks {
+			t.Run(geometry.name+track.name, func(t *testing.T) {
+				indices := geometry.decimate.DouglasPeuckerIndices(track.points, 5.0)
+				if !reflect.DeepEqual(indices, []int{0, 1, 2}) {
+					t.Errorf("DouglasPeuckerIndices(5.0) = %v; want %v", indices, []int{0, 1, 2})
+				}
+
+				indices = geometry.decimate.DouglasPeuckerIndices(track.points, 20.0)
+				if !reflect.DeepEqual(indices, []int{0, 2}) {
+					t.Errorf("DouglasPeuckerIndices(20.0) = %v; want %v", indices, []int{0, 2})
+				}
+			})
+		}
+	}
+}
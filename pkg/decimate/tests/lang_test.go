@@ -0,0 +1,237 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/primitives"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// langLookAhead is the size of the search region used to generate the fixtures stored in the
+// testdata/lang folder.
+const langLookAhead = 8
+
+// langFixtures lists the fixtures shared by the testdata/lang and testdata/douglas_peucker folders,
+// which hold the same input points.
+var langFixtures = []struct {
+	name     string
+	fixture  string
+	decimate *decimate.Decimate
+}{
+	{"SingleLine2DFixedOffset", "single_line_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+	{"SingleLine2DNoise", "single_line_2d_noise.json", geom2d.NewEuclid().Decimate},
+	{"Polyline2DFixedOffset", "polyline_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+	{"Polyline2DNoise", "polyline_2d_noise.json", geom2d.NewEuclid().Decimate},
+	{"Polyline3DNoise", "polyline_3d_noise.json", geom3d.NewEuclid().Decimate},
+}
+
+// TestLangOnlyTwoPointsInput tests the Lang function.
+// It checks if the function returns the same input when the input has only two points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLangOnlyTwoPointsInput(t *testing.T) {
+	points := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+	expected := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	points = geometry.Decimate.Lang(points, 0.1, 4)
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestLangLookAhead tests the Lang function.
+// It checks that collinear points are removed up to the end of the search region, so that the
+// look-ahead bounds the number of consecutive points removed.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLangLookAhead(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.0},
+		{2.0, 0.0},
+		{3.0, 0.0},
+		{4.0, 0.0},
+		{5.0, 0.0},
+		{6.0, 0.0},
+	}
+
+	tests := []struct {
+		name      string
+		lookAhead int
+		expected  []int
+	}{
+		{"One", 1, []int{0, 1, 2, 3, 4, 5, 6}},
+		{"Three", 3, []int{0, 3, 6}},
+		{"Four", 4, []int{0, 4, 6}},
+		{"Whole", 100, []int{0, 6}},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices := geometry.Decimate.LangIndices(points, 0.1, tt.lookAhead)
+			if len(indices) != len(tt.expected) {
+				t.Fatalf("LangIndices() = %v; want %v", indices, tt.expected)
+			}
+			for i := range indices {
+				if indices[i] != tt.expected[i] {
+					t.Fatalf("LangIndices() = %v; want %v", indices, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+// TestLangShrink tests the Lang function.
+// It checks that the search region is shrunk until no point inside it deviates from its line.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLangShrink(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.0},
+		{2.0, 0.0},
+		{3.0, 2.0},
+		{4.0, 0.0},
+	}
+	expected := [][]float64{
+		{0.0, 0.0},
+		{2.0, 0.0},
+		{3.0, 2.0},
+		{4.0, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	result, error := testutils.CompareSlices(geometry.Decimate.Lang(points, 0.5, 4), expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestLangInvalidInput tests the LangE function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLangInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := [][]float64{{0, 0}, {1, 1}}
+
+	if _, err := geometry.Decimate.LangE(points, 1, 0); !errors.Is(err, decimate.ErrInvalidWindow) {
+		t.Errorf("LangE() error = %v; want %v", err, decimate.ErrInvalidWindow)
+	}
+	if _, err := geometry.Decimate.LangE(points, -1, 4); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("LangE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+}
+
+// TestLangFixtures tests the Lang function against the fixtures stored in the testdata/lang
+// folder, for both 2D and 3D geometries. Fixtures were generated with a look-ahead of
+// langLookAhead points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLangFixtures(t *testing.T) {
+	for _, tt := range langFixtures {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/lang/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.Lang(data.Input, test.Epsilon, langLookAhead)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with tolerance %v, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+				}
+			}
+		})
+	}
+}
+
+// TestLangDouglasPeuckerFixtures tests the Lang function against the fixtures stored in the
+// testdata/douglas_peucker folder. Lang keeps a different set of points than Douglas-Peucker, so
+// it checks that every removed point lies within the tolerance of its simplified segment, as
+// Douglas-Peucker guarantees, and that no simplified segment spans more than the look-ahead.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLangDouglasPeuckerFixtures(t *testing.T) {
+	for _, tt := range langFixtures {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/douglas_peucker/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			geometry := tt.decimate.Geometry
+			for _, test := range data.Expected {
+				for _, lookAhead := range []int{2, langLookAhead, len(data.Input)} {
+					indices := tt.decimate.LangIndices(data.Input, test.Epsilon, lookAhead)
+					for k := 1; k < len(indices); k++ {
+						first, last := indices[k-1], indices[k]
+						if last-first > lookAhead {
+							t.Errorf("Segment %v-%v spans more than %v points", first, last, lookAhead)
+						}
+						line := primitives.NewLine(primitives.NewPoint(data.Input[first]), primitives.NewPoint(data.Input[last]))
+						for i := first + 1; i < last; i++ {
+							distance := geometry.DistancePointLine(primitives.NewPoint(data.Input[i]), line)
+							if distance > test.Epsilon {
+								t.Errorf("Point %v is %v away from segment %v-%v with tolerance %v", i, distance, first, last, test.Epsilon)
+							}
+						}
+					}
+				}
+			}
+		})
+	}
+}
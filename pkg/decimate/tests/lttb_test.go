@@ -0,0 +1,139 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geomnd"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// minMaxLTTBRatio is the number of points preselected for every kept point used to generate the
+// fixtures stored in the testdata/minmax_lttb folder, where epsilon is the threshold.
+const minMaxLTTBRatio = 4
+
+// TestLTTBShortSeries tests the LTTB function with series not longer than the threshold, and
+// with a threshold of two. It checks that every point, or the end points, are kept.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLTTBShortSeries(t *testing.T) {
+	points := [][]float64{{0, 1}, {1, 5}, {2, -3}, {3, 2}}
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		name      string
+		threshold int
+		expected  []int
+	}{
+		{"EqualThreshold", 4, []int{0, 1, 2, 3}},
+		{"LargerThreshold", 10, []int{0, 1, 2, 3}},
+		{"EndPoints", 2, []int{0, 3}},
+		{"SingleBucket", 3, []int{0, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if indices := geometry.Decimate.LTTBIndices(points, tt.threshold); !equalIndices(indices, tt.expected) {
+				t.Errorf("LTTBIndices() = %v; want %v", indices, tt.expected)
+			}
+			if indices := geometry.Decimate.MinMaxLTTBIndices(points, tt.threshold, minMaxLTTBRatio); len(indices) > max(tt.threshold, 2) {
+				t.Errorf("MinMaxLTTBIndices() = %v; want at most %v points", indices, tt.threshold)
+			}
+		})
+	}
+}
+
+// TestLTTBInvalidInput tests the LTTBE and MinMaxLTTBE functions with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLTTBInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := [][]float64{{0, 0}, {1, 1}, {2, 0}}
+
+	if _, err := geometry.Decimate.LTTBE(points, 1); !errors.Is(err, decimate.ErrInvalidPointBudget) {
+		t.Errorf("LTTBE() error = %v; want %v", err, decimate.ErrInvalidPointBudget)
+	}
+	if _, err := geometry.Decimate.MinMaxLTTBE(points, 1, minMaxLTTBRatio); !errors.Is(err, decimate.ErrInvalidPointBudget) {
+		t.Errorf("MinMaxLTTBE() error = %v; want %v", err, decimate.ErrInvalidPointBudget)
+	}
+	if _, err := geometry.Decimate.MinMaxLTTBE(points, 2, 0); !errors.Is(err, decimate.ErrInvalidParameter) || errors.Is(err, decimate.ErrInvalidPointBudget) {
+		t.Errorf("MinMaxLTTBE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+	unsorted := [][]float64{{0, 0}, {2, 1}, {1, 0}}
+	if _, err := geometry.Decimate.LTTBE(unsorted, 2); !errors.Is(err, decimate.ErrNotMonotonic) {
+		t.Errorf("LTTBE() error = %v; want %v", err, decimate.ErrNotMonotonic)
+	}
+	if _, err := geometry.Decimate.MinMaxLTTBE(unsorted, 2, minMaxLTTBRatio); !errors.Is(err, decimate.ErrNotMonotonic) {
+		t.Errorf("MinMaxLTTBE() error = %v; want %v", err, decimate.ErrNotMonotonic)
+	}
+	if _, err := geomnd.NewEuclid(1).Decimate.LTTBE([][]float64{{0}, {1}}, 2); !errors.Is(err, decimate.ErrUnsupportedDimension) {
+		t.Errorf("LTTBE() error = %v; want %v", err, decimate.ErrUnsupportedDimension)
+	}
+}
+
+// TestLTTBFixtures tests the LTTB and MinMaxLTTB functions against the fixtures stored in the
+// testdata/lttb and testdata/minmax_lttb folders. Fixtures were generated with minMaxLTTBRatio.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestLTTBFixtures(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		name      string
+		fixture   string
+		decimator func(points [][]float64, threshold int) [][]float64
+	}{
+		{"LTTB", "../../../testdata/lttb/sensor_series.json", geometry.Decimate.LTTB},
+		{"MinMaxLTTB", "../../../testdata/minmax_lttb/sensor_series.json", func(points [][]float64, threshold int) [][]float64 {
+			return geometry.Decimate.MinMaxLTTB(points, threshold, minMaxLTTBRatio)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader(tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				threshold := int(test.Epsilon)
+				points := tt.decimator(data.Input, threshold)
+				if len(points) != threshold {
+					t.Errorf("Kept %v points with threshold %v", len(points), threshold)
+				}
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with threshold %v, %v", threshold, error)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// TestM4Columns tests the M4Indices function.
+// It checks that the first, last, lowest and highest points of every column are kept, and that
+// a series with a single time is kept in a single column.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestM4Columns(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		name     string
+		points   [][]float64
+		columns  int
+		expected []int
+	}{
+		{"TwoColumns", [][]float64{{0, 0}, {1, 5}, {2, 1}, {3, -2}, {4, 3}, {5, 0}, {6, 9}, {7, -4}, {8, 1}, {10, 2}}, 2, []int{0, 1, 3, 4, 5, 6, 7, 9}},
+		{"EmptyColumn", [][]float64{{0, 0}, {1, 2}, {2, 1}, {9, 4}, {10, 3}}, 5, []int{0, 1, 2, 3, 4}},
+		{"SingleTime", [][]float64{{1, 0}, {1, 5}, {1, -1}, {1, 2}, {1, 3}}, 3, []int{0, 1, 2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if indices := geometry.Decimate.M4Indices(tt.points, tt.columns); !equalIndices(indices, tt.expected) {
+				t.Errorf("M4Indices() = %v; want %v", indices, tt.expected)
+			}
+		})
+	}
+}
+
+// TestM4InvalidInput tests the M4E function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestM4InvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	if _, err := geometry.Decimate.M4E([][]float64{{0, 0}, {1, 1}}, 0); !errors.Is(err, decimate.ErrInvalidPointBudget) {
+		t.Errorf("M4E() error = %v; want %v", err, decimate.ErrInvalidPointBudget)
+	}
+	if _, err := geometry.Decimate.M4E([][]float64{{1, 0}, {0, 1}}, 1); !errors.Is(err, decimate.ErrNotMonotonic) {
+		t.Errorf("M4E() error = %v; want %v", err, decimate.ErrNotMonotonic)
+	}
+}
+
+// TestM4Fixtures tests the M4 function against the fixtures stored in the testdata/m4 folder,
+// where epsilon is the number of columns.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestM4Fixtures(t *testing.T) {
+	data, err := testutils.JSONTestDataReader("../../../testdata/m4/sensor_series.json")
+	if err != nil {
+		t.Fatalf("Error while opening JSON file: %v", err)
+	}
+
+	geometry := geom2d.NewEuclid()
+	for _, test := range data.Expected {
+		columns := int(test.Epsilon)
+		points := geometry.Decimate.M4(data.Input, columns)
+		if len(points) > 4*columns {
+			t.Errorf("Kept %v points with %v columns", len(points), columns)
+		}
+		result, error := testutils.CompareSlices(points, test.Data)
+		if !result {
+			t.Errorf("The test failed with %v columns, %v", columns, error)
+		}
+	}
+}
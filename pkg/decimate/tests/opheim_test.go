@@ -0,0 +1,198 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// opheimMaxTolFactor is the ratio between maxTol and minTol used to generate the fixtures stored
+// in the testdata/opheim folder, where epsilon is minTol.
+const opheimMaxTolFactor = 5.0
+
+// TestOpheimOnlyTwoPointsInput tests the Opheim function.
+// It checks if the function returns the same input when the input has only two points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOpheimOnlyTwoPointsInput(t *testing.T) {
+	points := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+	expected := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	points = geometry.Decimate.Opheim(points, 0.1, 1.0)
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestOpheimMaxTol tests the Opheim function.
+// It checks that collinear points are all removed when maxTol is large enough, and that a key
+// point is kept whenever the next point is farther than maxTol from the current key point.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOpheimMaxTol(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.0},
+		{2.0, 0.0},
+		{3.0, 0.0},
+		{4.0, 0.0},
+		{5.0, 0.0},
+	}
+
+	tests := []struct {
+		name     string
+		maxTol   float64
+		expected []int
+	}{
+		{"Unbounded", 10.0, []int{0, 5}},
+		{"Bounded", 2.5, []int{0, 2, 4, 5}},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices := geometry.Decimate.OpheimIndices(points, 0.1, tt.maxTol)
+			if len(indices) != len(tt.expected) {
+				t.Fatalf("OpheimIndices() = %v; want %v", indices, tt.expected)
+			}
+			for i := range indices {
+				if indices[i] != tt.expected[i] {
+					t.Fatalf("OpheimIndices() = %v; want %v", indices, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+// TestOpheimRay tests the OpheimIndices function with a noisy point close to the key point.
+// It checks that, as in Opheim's definition and psimpl, the ray goes through the first point
+// farther than minTol from the key point instead of the noisy point before it, and through the
+// last point when every point lies within minTol. The expected results were traced by hand.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOpheimRay(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   [][]float64
+		expected []int
+	}{
+		// A ray through {0.5, 0.5} would leave {2, 0} at 1.41 and keep the noisy point.
+		{"NoisyStart", [][]float64{{0, 0}, {0.5, 0.5}, {2, 0}, {3, 0}, {4, 0}, {5, 0.3}}, []int{0, 5}},
+		{"WithinMinTol", [][]float64{{0, 0}, {0.2, 0.1}, {0.4, 0}}, []int{0, 2}},
+		{"Turn", [][]float64{{0, 0}, {0.5, 0.5}, {2, 0}, {3, 0}, {3, 2}, {3, 3}}, []int{0, 3, 5}},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if indices := geometry.Decimate.OpheimIndices(tt.points, 1.0, 10.0); !equalIndices(indices, tt.expected) {
+				t.Errorf("OpheimIndices() = %v; want %v", indices, tt.expected)
+			}
+		})
+	}
+}
+
+// TestOpheimInvalidInput tests the OpheimE function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOpheimInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := [][]float64{{0, 0}, {1, 1}}
+
+	if _, err := geometry.Decimate.OpheimE(points, -1, 1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("OpheimE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+	if _, err := geometry.Decimate.OpheimE(points, 1, -1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("OpheimE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+	if _, err := geometry.Decimate.OpheimE(points, 2, 1); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("OpheimE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+	if _, err := geometry.Decimate.OpheimE(nil, 1, 1); !errors.Is(err, decimate.ErrTooFewPoints) {
+		t.Errorf("OpheimE() error = %v; want %v", err, decimate.ErrTooFewPoints)
+	}
+}
+
+// TestOpheimFixtures tests the Opheim function against the fixtures stored in the testdata/opheim
+// folder, for both 2D and 3D geometries. Fixtures were generated with maxTol set to
+// opheimMaxTolFactor times minTol.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOpheimFixtures(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		decimate *decimate.Decimate
+	}{
+		{"SingleLine2DFixedOffset", "single_line_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"SingleLine2DNoise", "single_line_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DFixedOffset", "polyline_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DNoise", "polyline_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline3DNoise", "polyline_3d_noise.json", geom3d.NewEuclid().Decimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/opheim/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.Opheim(data.Input, test.Epsilon, opheimMaxTolFactor*test.Epsilon)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with minTol %v, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+				}
+			}
+		})
+	}
+}
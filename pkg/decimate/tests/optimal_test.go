@@ -0,0 +1,224 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// optimalFixtures lists the fixtures shared by the testdata/optimal and testdata/douglas_peucker
+// folders, which hold the same input points.
+var optimalFixtures = []struct {
+	name     string
+	fixture  string
+	decimate *decimate.Decimate
+}{
+	{"SingleLine2DFixedOffset", "single_line_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+	{"SingleLine2DNoise", "single_line_2d_noise.json", geom2d.NewEuclid().Decimate},
+	{"Polyline2DFixedOffset", "polyline_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+	{"Polyline2DNoise", "polyline_2d_noise.json", geom2d.NewEuclid().Decimate},
+	{"Polyline3DNoise", "polyline_3d_noise.json", geom3d.NewEuclid().Decimate},
+}
+
+// TestOptimalOnlyTwoPointsInput tests the Optimal function.
+// It checks if the function returns the same input when the input has only two points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOptimalOnlyTwoPointsInput(t *testing.T) {
+	points := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+	expected := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	points = geometry.Decimate.Optimal(points, 0.1)
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestOptimalFewerPointsThanDouglasPeucker tests the Optimal function.
+// It checks a polyline where the greedy splits of Douglas-Peucker keep four vertices, while the
+// optimal simplification only needs one.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOptimalFewerPointsThanDouglasPeucker(t *testing.T) {
+	points := [][]float64{
+		{0.0, 3.0},
+		{1.0, 0.0},
+		{2.0, 1.0},
+		{3.0, -1.0},
+		{4.0, 1.0},
+		{5.0, 1.0},
+		{6.0, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	optimal := geometry.Decimate.OptimalIndices(points, 1.0)
+	if len(optimal) != 3 || optimal[1] != 1 {
+		t.Errorf("OptimalIndices() = %v; want %v", optimal, []int{0, 1, 6})
+	}
+
+	greedy := geometry.Decimate.DouglasPeuckerIndices(points, 1.0)
+	if len(greedy) != 6 {
+		t.Errorf("DouglasPeuckerIndices() = %v; want %v", greedy, []int{0, 1, 2, 3, 4, 6})
+	}
+}
+
+// TestOptimalSegmentDistance tests the Optimal function with WithDistance(SegmentDistance).
+// It checks that the turning point of a track that doubles back on itself is kept.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOptimalSegmentDistance(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{2.0, 0.0},
+		{4.0, 0.0},
+		{3.0, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	line := geometry.Decimate.OptimalIndices(points, 0.1)
+	if len(line) != 2 {
+		t.Errorf("OptimalIndices() = %v; want %v", line, []int{0, 3})
+	}
+
+	segment := geometry.Decimate.OptimalIndices(points, 0.1, decimate.WithDistance(decimate.SegmentDistance))
+	if len(segment) != 3 || segment[1] != 2 {
+		t.Errorf("OptimalIndices() = %v; want %v", segment, []int{0, 2, 3})
+	}
+}
+
+// TestOptimalWindow tests the Optimal function with WithWindow.
+// It checks that simplified segments never span more points than the window, and that large
+// inputs are only accepted with a window small enough to bound the cost.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOptimalWindow(t *testing.T) {
+	points := make([][]float64, decimate.OptimalMaxPoints+1)
+	for i := range points {
+		points[i] = []float64{float64(i), 0.0}
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	if _, err := geometry.Decimate.OptimalIndicesE(points, 0.1); !errors.Is(err, decimate.ErrInputTooLarge) {
+		t.Errorf("OptimalIndicesE() error = %v; want %v", err, decimate.ErrInputTooLarge)
+	}
+	if _, err := geometry.Decimate.OptimalIndicesE(points, 0.1, decimate.WithWindow(len(points)-2)); !errors.Is(err, decimate.ErrInputTooLarge) {
+		t.Errorf("OptimalIndicesE() error = %v with a window just under the input; want %v", err, decimate.ErrInputTooLarge)
+	}
+	if _, err := geometry.Decimate.OptimalIndicesE(points[:decimate.OptimalMaxPoints], 0.1, decimate.WithWindow(decimate.OptimalMaxPoints-2)); err != nil {
+		t.Errorf("OptimalIndicesE() returned an error: %v", err)
+	}
+	if _, err := geometry.Decimate.OptimalIndicesE(points, 0.1, decimate.WithWindow(0)); !errors.Is(err, decimate.ErrInvalidWindow) {
+		t.Errorf("OptimalIndicesE() error = %v; want %v", err, decimate.ErrInvalidWindow)
+	}
+
+	indices, err := geometry.Decimate.OptimalIndicesE(points, 0.1, decimate.WithWindow(100))
+	if err != nil {
+		t.Fatalf("OptimalIndicesE() returned an error: %v", err)
+	}
+	if len(indices) != 6 {
+		t.Errorf("OptimalIndicesE() = %v; want 6 points", indices)
+	}
+	for k := 1; k < len(indices); k++ {
+		if indices[k]-indices[k-1] > 100 {
+			t.Errorf("Segment %v-%v spans more than 100 points", indices[k-1], indices[k])
+		}
+	}
+}
+
+// TestOptimalFixtures tests the Optimal function against the fixtures stored in the
+// testdata/optimal folder, for both 2D and 3D geometries.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOptimalFixtures(t *testing.T) {
+	for _, tt := range optimalFixtures {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/optimal/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.Optimal(data.Input, test.Epsilon)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with epsilon %v, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+				}
+			}
+		})
+	}
+}
+
+// TestOptimalDouglasPeuckerFixtures tests the Optimal function against the fixtures stored in the
+// testdata/douglas_peucker folder. It checks that it never keeps more points than DouglasPeucker
+// with the same threshold.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestOptimalDouglasPeuckerFixtures(t *testing.T) {
+	for _, tt := range optimalFixtures {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/douglas_peucker/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.Optimal(data.Input, test.Epsilon)
+				if len(points) > len(test.Data) {
+					t.Errorf("The test failed with epsilon %v, kept %v points, more than the %v of DouglasPeucker", test.Epsilon, len(points), len(test.Data))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// perpendicularDistanceRepeat is the number of passes used to generate the fixtures stored in the
+// testdata/perpendicular_distance folder.
+const perpendicularDistanceRepeat = 3
+
+// TestPerpendicularDistanceRepeat tests the PerpendicularDistance function.
+// It checks that a single pass never removes two consecutive points, and that further passes keep
+// removing points until no point is closer than the tolerance to its neighbours' line.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestPerpendicularDistanceRepeat(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.0},
+		{2.0, 0.0},
+		{3.0, 0.0},
+		{4.0, 0.0},
+		{5.0, 0.0},
+		{6.0, 0.0},
+		{7.0, 0.0},
+		{8.0, 0.0},
+	}
+
+	tests := []struct {
+		name     string
+		repeat   int
+		expected []int
+	}{
+		{"One", 1, []int{0, 2, 4, 6, 8}},
+		{"Two", 2, []int{0, 4, 8}},
+		{"Many", 10, []int{0, 8}},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices := geometry.Decimate.PerpendicularDistanceIndices(points, 0.1, tt.repeat)
+			if len(indices) != len(tt.expected) {
+				t.Fatalf("PerpendicularDistanceIndices() = %v; want %v", indices, tt.expected)
+			}
+			for i := range indices {
+				if indices[i] != tt.expected[i] {
+					t.Fatalf("PerpendicularDistanceIndices() = %v; want %v", indices, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+// TestPerpendicularDistanceInvalidInput tests the PerpendicularDistanceE function with invalid
+// inputs. It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestPerpendicularDistanceInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := [][]float64{{0, 0}, {1, 1}}
+
+	if _, err := geometry.Decimate.PerpendicularDistanceE(points, 1, 0); !errors.Is(err, decimate.ErrInvalidRepeat) {
+		t.Errorf("PerpendicularDistanceE() error = %v; want %v", err, decimate.ErrInvalidRepeat)
+	}
+	if _, err := geometry.Decimate.PerpendicularDistanceE(points, -1, 1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("PerpendicularDistanceE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+}
+
+// TestPerpendicularDistanceFixtures tests the PerpendicularDistance function against the fixtures
+// stored in the testdata/perpendicular_distance folder, for both 2D and 3D geometries. Fixtures
+// were generated with perpendicularDistanceRepeat passes.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestPerpendicularDistanceFixtures(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		decimate *decimate.Decimate
+	}{
+		{"SingleLine2DFixedOffset", "single_line_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"SingleLine2DNoise", "single_line_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DFixedOffset", "polyline_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DNoise", "polyline_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline3DNoise", "polyline_3d_noise.json", geom3d.NewEuclid().Decimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/perpendicular_distance/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.PerpendicularDistance(data.Input, test.Epsilon, perpendicularDistanceRepeat)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with tolerance %v, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+				}
+			}
+		})
+	}
+}
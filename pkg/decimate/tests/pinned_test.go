@@ -0,0 +1,218 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"math"
+	"slices"
+	"testing"
+)
+
+// pinnedAlgorithms lists the algorithms accepting WithPinned, with a threshold suited to wavyTrack.
+var pinnedAlgorithms = []struct {
+	name    string
+	indices func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error)
+}{
+	{"DouglasPeucker", func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error) {
+		return d.DouglasPeuckerIndicesE(points, 0.5, opts...)
+	}},
+	{"Optimal", func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error) {
+		return d.OptimalIndicesE(points, 0.5, opts...)
+	}},
+	{"VisvalingamWhyatt", func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error) {
+		return d.VisvalingamWhyattIndicesE(points, 0.2, opts...)
+	}},
+	{"ReumannWitkam", func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error) {
+		return d.ReumannWitkamIndicesE(points, 0.5, opts...)
+	}},
+	{"Opheim", func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error) {
+		return d.OpheimIndicesE(points, 0.5, 2.5, opts...)
+	}},
+	{"Lang", func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error) {
+		return d.LangIndicesE(points, 0.5, 8, opts...)
+	}},
+	{"RadialDistance", func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error) {
+		return d.RadialDistanceIndicesE(points, 1.5, opts...)
+	}},
+	{"PerpendicularDistance", func(d decimate.Decimate, points [][]float64, opts ...decimate.Option) ([]int, error) {
+		return d.PerpendicularDistanceIndicesE(points, 0.5, 3, opts...)
+	}},
+}
+
+// wavyTrack returns a slowly oscillating track with a deterministic jitter.
+//
+// Parameters:
+//   - size (int): The number of points of the track.
+//
+// Returns:
+//   - [][]float64: The points of the track.
+func wavyTrack(size int) [][]float64 {
+	points := make([][]float64, size)
+	for i := range points {
+		x := float64(i) * 0.5
+		points[i] = []float64{x, 2*math.Sin(x/3) + 0.2*math.Sin(float64(i)*2.39996)}
+	}
+	return points
+}
+
+// TestPinnedVertices tests every algorithm accepting WithPinned.
+// It checks that pinned vertices are kept, given in any order and with duplicates, and that the
+// result is the one of simplifying the sections between pinned vertices independently.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestPinnedVertices(t *testing.T) {
+	points := wavyTrack(60)
+	pinned := []int{41, 7, 23, 41}
+	bounds := []int{0, 7, 23, 41, len(points) - 1}
+	d := *geom2d.NewEuclid().Decimate
+
+	for _, tt := range pinnedAlgorithms {
+		t.Run(tt.name, func(t *testing.T) {
+			indices, err := tt.indices(d, points, decimate.WithPinned(pinned...))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var expected []int
+			for k := 1; k < len(bounds); k++ {
+				section, err := tt.indices(d, points[bounds[k-1]:bounds[k]+1])
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if k > 1 {
+					section = section[1:]
+				}
+				for _, index := range section {
+					expected = append(expected, bounds[k-1]+index)
+				}
+			}
+			if !equalIndices(indices, expected) {
+				t.Errorf("Indices = %v; want %v", indices, expected)
+			}
+		})
+	}
+}
+
+// TestPinnedVerticesFamily tests DouglasPeuckerN and SimplificationIndex with pinned vertices.
+// It checks that they agree with DouglasPeucker, and that DouglasPeuckerN and TopNIndices keep
+// every pinned vertex and both end points even beyond their point budget.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestPinnedVerticesFamily(t *testing.T) {
+	points := wavyTrack(60)
+	geometry := geom2d.NewEuclid()
+	option := decimate.WithPinned(23, 7, 41)
+
+	expected := geometry.Decimate.DouglasPeuckerIndices(points, 0.5, option)
+
+	index := geometry.Decimate.SimplificationIndex(points, option)
+	if result := index.AtIndices(0.5); !equalIndices(result, expected) {
+		t.Errorf("AtIndices() = %v; want %v", result, expected)
+	}
+	if result := geometry.Decimate.DouglasPeuckerNIndices(points, len(expected), option); !equalIndices(result, expected) {
+		t.Errorf("DouglasPeuckerNIndices() = %v; want %v", result, expected)
+	}
+
+	want := []int{0, 7, 23, 41, len(points) - 1}
+	if result := geometry.Decimate.DouglasPeuckerNIndices(points, 2, option); !equalIndices(result, want) {
+		t.Errorf("DouglasPeuckerNIndices() = %v; want %v", result, want)
+	}
+	for _, n := range []int{0, 2, len(want)} {
+		if result := index.TopNIndices(n); !equalIndices(result, want) {
+			t.Errorf("TopNIndices(%v) = %v; want %v", n, result, want)
+		}
+	}
+	if result := index.TopNIndices(len(want) + 1); len(result) != len(want)+1 || !containsIndices(result, want) {
+		t.Errorf("TopNIndices(%v) = %v; want %v and one more vertex", len(want)+1, result, want)
+	}
+}
+
+// TestPinnedVerticesWithPrefilter tests the DouglasPeucker function with pinned vertices and a
+// pre-filter. It checks that a pinned vertex removed by the pre-filter alone is kept.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestPinnedVerticesWithPrefilter(t *testing.T) {
+	points := stationaryTrack(50)
+	geometry := geom2d.NewEuclid()
+	prefilter := decimate.WithPrefilter(decimate.RadialDistancePrefilter(2.0))
+
+	pinned := 60
+	for _, index := range geometry.Decimate.DouglasPeuckerIndices(points, 1.0, prefilter) {
+		if index == pinned {
+			t.Fatalf("Point %v is kept without being pinned", pinned)
+		}
+	}
+
+	indices := geometry.Decimate.DouglasPeuckerIndices(points, 1.0, prefilter, decimate.WithPinned(pinned))
+	found := false
+	for _, index := range indices {
+		found = found || index == pinned
+	}
+	if !found {
+		t.Errorf("DouglasPeuckerIndices() = %v; want pinned point %v", indices, pinned)
+	}
+}
+
+// TestPinnedVerticesOutOfRange tests every algorithm accepting WithPinned with invalid positions.
+// It checks that ErrPinnedOutOfRange is returned.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestPinnedVerticesOutOfRange(t *testing.T) {
+	points := wavyTrack(10)
+	d := *geom2d.NewEuclid().Decimate
+
+	for _, tt := range pinnedAlgorithms {
+		for _, pinned := range []int{-1, len(points)} {
+			if _, err := tt.indices(d, points, decimate.WithPinned(pinned)); !errors.Is(err, decimate.ErrPinnedOutOfRange) {
+				t.Errorf("%v with pinned %v: error = %v; want %v", tt.name, pinned, err, decimate.ErrPinnedOutOfRange)
+			}
+		}
+	}
+}
+
+// containsIndices reports whether every position of subset is in indices.
+//
+// Parameters:
+//   - indices ([]int): The positions to search in.
+//   - subset ([]int): The positions to be found.
+//
+// Returns:
+//   - bool: True if every position of subset is found, false otherwise.
+func containsIndices(indices, subset []int) bool {
+	for _, position := range subset {
+		if !slices.Contains(indices, position) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,321 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// noisyRing returns a closed ring around a center, made of points at a noisy distance from it.
+//
+// Parameters:
+//   - random (*rand.Rand): The source of the noise.
+//   - x (float64): The x coordinate of the center.
+//   - y (float64): The y coordinate of the center.
+//   - radius (float64): The mean distance of the points to the center.
+//   - noise (float64): The largest deviation of the distance of the points to the center.
+//   - clockwise (bool): True for a clockwise ring, false for a counterclockwise one.
+//
+// Returns:
+//   - [][]float64: The points of the ring.
+func noisyRing(random *rand.Rand, x, y, radius, noise float64, clockwise bool) [][]float64 {
+	var ring [][]float64
+	for i := 0; i < 100; i++ {
+		angle := 2 * math.Pi * float64(i) / 100
+		if clockwise {
+			angle = -angle
+		}
+		r := radius + noise*(2*random.Float64()-1)
+		ring = append(ring, []float64{x + r*math.Cos(angle), y + r*math.Sin(angle)})
+	}
+	return append(ring, ring[0])
+}
+
+// ringArea computes the signed area of a closed ring, positive when counterclockwise.
+func ringArea(ring [][]float64) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	return area / 2
+}
+
+// checkRing checks that a simplified ring is closed, encloses a triangle and has the
+// orientation of the original ring.
+func checkRing(t *testing.T, name string, original, ring [][]float64) {
+	t.Helper()
+	if len(ring) < 4 {
+		t.Errorf("%v has %v points; want at least 4", name, len(ring))
+		return
+	}
+	if !equalCoordinates(ring[0], ring[len(ring)-1]) {
+		t.Errorf("%v is not closed: %v", name, ring)
+	}
+	if ringArea(original)*ringArea(ring) <= 0 {
+		t.Errorf("%v has area %v; want the sign of %v", name, ringArea(ring), ringArea(original))
+	}
+}
+
+// TestSimplifyPolygonHoles tests the SimplifyPolygon function.
+// It checks that the rings stay closed and keep their orientation, and that only the holes
+// smaller than the minimum area are dropped.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifyPolygonHoles(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	polygon := decimate.Polygon{
+		Outer: noisyRing(random, 0, 0, 10, 0.5, false),
+		Holes: [][][]float64{
+			noisyRing(random, -4, 0, 2, 0.2, true),
+			noisyRing(random, 4, 0, 0.5, 0.05, true),
+		},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	for _, tol := range []float64{0.01, 0.3, 1.0, 100.0} {
+		result := geometry.Decimate.SimplifyPolygon(polygon, tol)
+		if len(result.Holes) != 2 {
+			t.Fatalf("SimplifyPolygon() kept %v holes with tolerance %v; want 2", len(result.Holes), tol)
+		}
+		checkRing(t, "Outer ring", polygon.Outer, result.Outer)
+		for h, hole := range result.Holes {
+			checkRing(t, "Hole", polygon.Holes[h], hole)
+		}
+	}
+
+	indices := geometry.Decimate.SimplifyPolygonIndices(polygon, 0.3, decimate.WithMinArea(1.0))
+	if indices.Holes[0] == nil || indices.Holes[1] != nil {
+		t.Errorf("SimplifyPolygonIndices() holes = %v; want only the second one dropped", indices.Holes)
+	}
+
+	result := geometry.Decimate.SimplifyPolygon(polygon, 0.3, decimate.WithMinArea(1.0))
+	if len(result.Holes) != 1 {
+		t.Errorf("SimplifyPolygon() kept %v holes; want 1", len(result.Holes))
+	}
+}
+
+// TestSimplifyPolygonTriangle tests the SimplifyPolygon function.
+// It checks that a thin ring, which Douglas-Peucker reduces to two distinct points, still
+// encloses a triangle.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifyPolygonTriangle(t *testing.T) {
+	ring := [][]float64{{0, 0}, {5, 0.1}, {10, 0}, {5, 0.3}, {0, 0}}
+
+	geometry := geom2d.NewEuclid()
+
+	if simplified := geometry.Decimate.DouglasPeucker(ring, 1.0); len(simplified) != 3 {
+		t.Fatalf("DouglasPeucker() = %v; want 3 points", simplified)
+	}
+
+	result := geometry.Decimate.SimplifyPolygon(decimate.Polygon{Outer: ring}, 1.0)
+	checkRing(t, "Outer ring", ring, result.Outer)
+}
+
+// TestSimplifyPolygonDuplicateVertices tests the SimplifyPolygon function with rings repeating
+// some of their vertices.
+// It checks that repeated vertices do not count towards the triangle enclosed by the result,
+// which has three distinct points besides the closing one and the orientation of the input.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifyPolygonDuplicateVertices(t *testing.T) {
+	tests := []struct {
+		name string
+		ring [][]float64
+	}{
+		{"ThinRing", [][]float64{{0, 0}, {0, 0}, {5, 0.1}, {10, 0}, {10, 0}, {5, 0.3}, {5, 0.3}, {0, 0}}},
+		{"DoubledSquare", [][]float64{{0, 0}, {0, 0}, {10, 0}, {10, 0}, {10, 10}, {10, 10}, {0, 10}, {0, 10}, {0, 0}}},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := geometry.Decimate.SimplifyPolygon(decimate.Polygon{Outer: tt.ring}, 100.0)
+			checkRing(t, "Outer ring", tt.ring, result.Outer)
+
+			var distinct [][]float64
+			for _, point := range result.Outer[:len(result.Outer)-1] {
+				if !slices.ContainsFunc(distinct, func(other []float64) bool { return equalCoordinates(point, other) }) {
+					distinct = append(distinct, point)
+				}
+			}
+			if len(distinct) < 3 {
+				t.Errorf("SimplifyPolygon() = %v; want three distinct points", result.Outer)
+			}
+		})
+	}
+}
+
+// TestSimplifyPolygonOrientationFlip tests the SimplifyPolygon function.
+// It checks a ring whose Douglas-Peucker simplification has the opposite orientation, and that
+// the polygon simplification adds points back until the orientation is kept.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifyPolygonOrientationFlip(t *testing.T) {
+	ring := [][]float64{
+		{2.0, 0.0},
+		{0.08, 0.06},
+		{0.35, 1.97},
+		{-0.05, 0.09},
+		{-2.82, 1.03},
+		{-0.09, -0.03},
+		{-0.05, -0.09},
+		{0.02, -0.1},
+		{0.77, -0.64},
+		{2.0, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	simplified := geometry.Decimate.DouglasPeucker(ring, 1.0)
+	if len(simplified) < 4 || ringArea(simplified)*ringArea(ring) > 0 {
+		t.Fatalf("DouglasPeucker() = %v; want a ring with the opposite orientation", simplified)
+	}
+
+	result := geometry.Decimate.SimplifyPolygon(decimate.Polygon{Outer: ring}, 1.0)
+	checkRing(t, "Outer ring", ring, result.Outer)
+}
+
+// TestSimplifyPolygonOrientation tests the SimplifyPolygon function on random star-shaped rings.
+// It checks that the simplified rings always keep the orientation of the original ones.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifyPolygonOrientation(t *testing.T) {
+	random := rand.New(rand.NewSource(11))
+	geometry := geom2d.NewEuclid()
+
+	for i := 0; i < 50; i++ {
+		ring := noisyRing(random, 0, 0, 1, 0.9, i%2 == 0)
+		for _, tol := range []float64{0.1, 0.5, 1.0, 5.0} {
+			result := geometry.Decimate.SimplifyPolygon(decimate.Polygon{Outer: ring}, tol)
+			checkRing(t, "Outer ring", ring, result.Outer)
+		}
+	}
+}
+
+// TestSimplifyMultiPolygonIslands tests the SimplifyMultiPolygon function.
+// It checks that the islands smaller than the minimum area are dropped.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifyMultiPolygonIslands(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	polygons := []decimate.Polygon{
+		{Outer: noisyRing(random, 0, 0, 10, 0.5, false)},
+		{Outer: noisyRing(random, 20, 0, 0.5, 0.05, false)},
+		{Outer: noisyRing(random, 0, 20, 3, 0.2, false)},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	if result := geometry.Decimate.SimplifyMultiPolygon(polygons, 0.3); len(result) != 3 {
+		t.Errorf("SimplifyMultiPolygon() kept %v polygons; want 3", len(result))
+	}
+
+	indices := geometry.Decimate.SimplifyMultiPolygonIndices(polygons, 0.3, decimate.WithMinArea(1.0))
+	if indices[0].Outer == nil || indices[1].Outer != nil || indices[2].Outer == nil {
+		t.Errorf("SimplifyMultiPolygonIndices() = %v; want only the second polygon dropped", indices)
+	}
+
+	result := geometry.Decimate.SimplifyMultiPolygon(polygons, 0.3, decimate.WithMinArea(1.0))
+	if len(result) != 2 {
+		t.Errorf("SimplifyMultiPolygon() kept %v polygons; want 2", len(result))
+	}
+}
+
+// TestSimplifyPolygonInvalidInput tests the SimplifyPolygonE function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifyPolygonInvalidInput(t *testing.T) {
+	square := [][]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		name     string
+		polygon  decimate.Polygon
+		minArea  float64
+		expected error
+	}{
+		{"NotClosed", decimate.Polygon{Outer: square[:4]}, 0, decimate.ErrInvalidRing},
+		{"Segment", decimate.Polygon{Outer: [][]float64{{0, 0}, {1, 0}, {0, 0}}}, 0, decimate.ErrInvalidRing},
+		{"HoleNotClosed", decimate.Polygon{Outer: square, Holes: [][][]float64{square[1:]}}, 0, decimate.ErrInvalidRing},
+		{"NegativeMinArea", decimate.Polygon{Outer: square}, -1, decimate.ErrNegativeThreshold},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := geometry.Decimate.SimplifyPolygonE(tt.polygon, 0.1, decimate.WithMinArea(tt.minArea))
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("SimplifyPolygonE() error = %v; want %v", err, tt.expected)
+			}
+		})
+	}
+
+	polygon := decimate.Polygon{Outer: [][]float64{{0, 0, 0}, {1, 0, 0}, {1, 1, 0}, {0, 0, 0}}}
+	if _, err := geom3d.NewEuclid().Decimate.SimplifyPolygonE(polygon, 0.1); !errors.Is(err, decimate.ErrUnsupportedDimension) {
+		t.Errorf("SimplifyPolygonE() error = %v; want %v", err, decimate.ErrUnsupportedDimension)
+	}
+	if _, err := geometry.Decimate.SimplifyMultiPolygonE(nil, 0.1); !errors.Is(err, decimate.ErrTooFewPoints) {
+		t.Errorf("SimplifyMultiPolygonE() error = %v; want %v", err, decimate.ErrTooFewPoints)
+	}
+	if _, err := geometry.Decimate.SimplifyPolygonE(decimate.Polygon{Outer: square}, 0.1, decimate.WithPinned(1)); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("SimplifyPolygonE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+	if _, err := geometry.Decimate.SimplifyPolygonE(decimate.Polygon{Outer: square}, 0.1, decimate.WithCornerAngle(math.Pi/4)); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("SimplifyPolygonE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+	if _, err := geometry.Decimate.SimplifyMultiPolygonE([]decimate.Polygon{{Outer: square}}, 0.1, decimate.WithPinned(1)); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("SimplifyMultiPolygonE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+	if _, err := geometry.Decimate.SimplifyMultiPolygonE([]decimate.Polygon{{Outer: square}}, 0.1, decimate.WithCornerAngle(math.Pi/4)); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("SimplifyMultiPolygonE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"math"
+	"testing"
+)
+
+// stationaryTrack returns a track along a square with clusters of near-duplicate points, as
+// recorded by a receiver standing still, at every corner.
+//
+// Parameters:
+//   - clusterSize (int): The number of points in every cluster.
+//
+// Returns:
+//   - [][]float64: The points of the track.
+func stationaryTrack(clusterSize int) [][]float64 {
+	corners := [][]float64{{0, 0}, {100, 0}, {100, 100}, {0, 100}}
+
+	var points [][]float64
+	for c, corner := range corners {
+		for i := 0; i < clusterSize; i++ {
+			angle := float64(i+c) * 2.39996
+			jitter := 0.5 * math.Sqrt(float64(i)/float64(clusterSize))
+			points = append(points, []float64{corner[0] + jitter*math.Cos(angle), corner[1] + jitter*math.Sin(angle)})
+		}
+		if c < len(corners)-1 {
+			next := corners[c+1]
+			for i := 1; i < 10; i++ {
+				f := float64(i) / 10
+				points = append(points, []float64{corner[0] + f*(next[0]-corner[0]), corner[1] + f*(next[1]-corner[1])})
+			}
+		}
+	}
+	return points
+}
+
+// equalIndices reports whether two lists of positions are equal.
+func equalIndices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestDouglasPeuckerWithPrefilter tests the DouglasPeucker function with a pre-filter.
+// It checks that the pre-filter shrinks the input by an order of magnitude, that the returned
+// positions refer to the input list, and that the result keeps the shape of the track.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerWithPrefilter(t *testing.T) {
+	points := stationaryTrack(200)
+	geometry := geom2d.NewEuclid()
+
+	reduced := geometry.Decimate.RadialDistanceIndices(points, 2.0)
+	if len(reduced)*10 > len(points) {
+		t.Errorf("RadialDistanceIndices() kept %v of %v points", len(reduced), len(points))
+	}
+
+	tests := []struct {
+		name      string
+		prefilter decimate.Prefilter
+	}{
+		{"RadialDistance", decimate.RadialDistancePrefilter(2.0)},
+		{"PerpendicularDistance", decimate.PerpendicularDistancePrefilter(0.5, 5)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices := geometry.Decimate.DouglasPeuckerIndices(points, 1.0, decimate.WithPrefilter(tt.prefilter))
+			if len(indices) != 4 || indices[0] != 0 || indices[len(indices)-1] != len(points)-1 {
+				t.Fatalf("DouglasPeuckerIndices() = %v; want four points from 0 to %v", indices, len(points)-1)
+			}
+			corners := [][]float64{{0, 0}, {100, 0}, {100, 100}, {0, 100}}
+			for i, index := range indices {
+				corner := corners[i]
+				if math.Hypot(points[index][0]-corner[0], points[index][1]-corner[1]) > 1.0 {
+					t.Errorf("Point %v at %v is not close to corner %v", index, points[index], corner)
+				}
+			}
+
+			filtered := geometry.Decimate.DouglasPeucker(points, 1.0, decimate.WithPrefilter(tt.prefilter))
+			if len(filtered) != len(indices) || &filtered[1][0] != &points[indices[1]][0] {
+				t.Errorf("DouglasPeucker() = %v; want the points at %v", filtered, indices)
+			}
+		})
+	}
+}
+
+// TestDouglasPeuckerFamilyWithPrefilter tests DouglasPeuckerN and SimplificationIndex with a
+// pre-filter. It checks that they return the same positions as DouglasPeucker, and that points
+// removed by the pre-filter have a significance of zero.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerFamilyWithPrefilter(t *testing.T) {
+	points := stationaryTrack(50)
+	geometry := geom2d.NewEuclid()
+	option := decimate.WithPrefilter(decimate.RadialDistancePrefilter(2.0))
+
+	expected := geometry.Decimate.DouglasPeuckerIndices(points, 1.0, option)
+
+	index := geometry.Decimate.SimplificationIndex(points, option)
+	if result := index.AtIndices(1.0); !equalIndices(result, expected) {
+		t.Errorf("AtIndices() = %v; want %v", result, expected)
+	}
+	if result := geometry.Decimate.DouglasPeuckerNIndices(points, len(expected), option); !equalIndices(result, expected) {
+		t.Errorf("DouglasPeuckerNIndices() = %v; want %v", result, expected)
+	}
+
+	kept := geometry.Decimate.RadialDistanceIndices(points, 2.0)
+	removed := 0
+	for i := range points {
+		if removed < len(kept) && kept[removed] == i {
+			removed++
+			continue
+		}
+		if index.Significance(i) != 0 {
+			t.Errorf("Significance(%v) = %v; want 0", i, index.Significance(i))
+		}
+	}
+}
+
+// TestDouglasPeuckerWithPrefilterError tests the DouglasPeuckerE function with an invalid
+// pre-filter. It checks that the error of the pre-filter is returned.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerWithPrefilterError(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	option := decimate.WithPrefilter(decimate.PerpendicularDistancePrefilter(1.0, 0))
+
+	if _, err := geometry.Decimate.DouglasPeuckerE([][]float64{{0, 0}, {1, 1}}, 1.0, option); !errors.Is(err, decimate.ErrInvalidRepeat) {
+		t.Errorf("DouglasPeuckerE() error = %v; want %v", err, decimate.ErrInvalidRepeat)
+	}
+}
+
+// TestDouglasPeuckerWithInvalidPrefilter tests the DouglasPeuckerIndicesE function with
+// pre-filters that return malformed positions. It checks that every result that does not
+// start at the first point, end at the last one and increase strictly is rejected.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerWithInvalidPrefilter(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := wavyTrack(50)
+
+	tests := map[string][]int{
+		"empty":         {},
+		"single":        {0},
+		"missing first": {1, 20, 49},
+		"missing last":  {0, 20, 48},
+		"out of range":  {0, 20, 50},
+		"decreasing":    {0, 30, 20, 49},
+		"duplicate":     {0, 20, 20, 49},
+	}
+
+	for name, result := range tests {
+		t.Run(name, func(t *testing.T) {
+			prefilter := func(d decimate.Decimate, points [][]float64) ([]int, error) {
+				return result, nil
+			}
+
+			got, err := geometry.Decimate.DouglasPeuckerIndicesE(points, 0.1, decimate.WithPrefilter(prefilter))
+			if !errors.Is(err, decimate.ErrInvalidParameter) {
+				t.Errorf("DouglasPeuckerIndicesE() = %v, %v; want error %v", got, err, decimate.ErrInvalidParameter)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"github.com/cenieto/decimate/pkg/projected"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// TestDouglasPeuckerProjected tests the DouglasPeucker function with the projected geometries.
+// It checks that the threshold is expressed in meters with UTM and in pixels with Web Mercator,
+// while the output stays in longitude and latitude.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerProjected(t *testing.T) {
+	// The second point is about 10 meters north of the line joining the others.
+	points := [][]float64{{-3.70, 40.40}, {-3.69, 40.40009}, {-3.68, 40.40}}
+
+	utm, err := projected.NewUTMFor(points)
+	if err != nil {
+		t.Fatalf("NewUTMFor() returned an error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		geometry  *projected.Euclid
+		threshold float64
+		expected  [][]float64
+	}{
+		{"UTMMeters", utm, 5.0, points},
+		{"UTMMetersAbove", utm, 20.0, [][]float64{points[0], points[2]}},
+		// A pixel is about 117 meters at zoom 10 and 0.46 meters at zoom 18 at this latitude.
+		{"WebMercatorZoom10", projected.NewWebMercatorPixels(10), 1.0, [][]float64{points[0], points[2]}},
+		{"WebMercatorZoom18", projected.NewWebMercatorPixels(18), 1.0, points},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.geometry.Decimate.DouglasPeucker(points, tt.threshold)
+			equal, error := testutils.CompareSlices(result, tt.expected)
+			if !equal {
+				t.Errorf("The test failed with threshold %v, %v, expected: %v\n, result: %v", tt.threshold, error, tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// TestRadialDistanceCluster tests the RadialDistance function.
+// It checks that a cluster of near-duplicate points is collapsed into its first point, and that
+// the last point is kept even when it is close to the previous kept point.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestRadialDistanceCluster(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{5.0, 0.0},
+		{5.1, 0.1},
+		{4.9, 0.0},
+		{5.0, -0.1},
+		{10.0, 0.0},
+		{10.2, 0.0},
+	}
+	expected := [][]float64{
+		{0.0, 0.0},
+		{5.0, 0.0},
+		{10.0, 0.0},
+		{10.2, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	result, error := testutils.CompareSlices(geometry.Decimate.RadialDistance(points, 1.0), expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+
+	result, error = testutils.CompareSlices(geometry.Decimate.RadialDistance(points, 0.0), points)
+	if !result {
+		t.Errorf("The test failed with a zero distance, %v", error)
+	}
+}
+
+// TestRadialDistanceInvalidInput tests the RadialDistanceE function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestRadialDistanceInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	if _, err := geometry.Decimate.RadialDistanceE([][]float64{{0, 0}, {1, 1}}, -1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("RadialDistanceE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+	if _, err := geometry.Decimate.RadialDistanceE([][]float64{{0, 0}}, 1); !errors.Is(err, decimate.ErrTooFewPoints) {
+		t.Errorf("RadialDistanceE() error = %v; want %v", err, decimate.ErrTooFewPoints)
+	}
+}
+
+// TestRadialDistanceFixtures tests the RadialDistance function against the fixtures stored in the
+// testdata/radial_distance folder, for both 2D and 3D geometries.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestRadialDistanceFixtures(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		decimate *decimate.Decimate
+	}{
+		{"SingleLine2DFixedOffset", "single_line_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"SingleLine2DNoise", "single_line_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DFixedOffset", "polyline_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DNoise", "polyline_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline3DNoise", "polyline_3d_noise.json", geom3d.NewEuclid().Decimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/radial_distance/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.RadialDistance(data.Input, test.Epsilon)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with minimum distance %v, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+				}
+			}
+		})
+	}
+}
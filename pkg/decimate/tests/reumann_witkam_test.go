@@ -0,0 +1,146 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// TestReumannWitkamOnlyTwoPointsInput tests the ReumannWitkam function.
+// It checks if the function returns the same input when the input has only two points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestReumannWitkamOnlyTwoPointsInput(t *testing.T) {
+	points := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+	expected := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	points = geometry.Decimate.ReumannWitkam(points, 0.1)
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestReumannWitkamStrip tests the ReumannWitkam function.
+// It checks that a new key point is kept right before the first point leaving the strip, and that
+// the strip then follows the direction of the new key segment.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestReumannWitkamStrip(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.0},
+		{2.0, 0.05},
+		{3.0, 0.0},
+		{4.0, 1.0},
+		{5.0, 2.0},
+		{6.0, 3.05},
+	}
+	expected := [][]float64{
+		{0.0, 0.0},
+		{3.0, 0.0},
+		{6.0, 3.05},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	indices := geometry.Decimate.ReumannWitkamIndices(points, 0.1)
+	if len(indices) != 3 || indices[0] != 0 || indices[1] != 3 || indices[2] != 6 {
+		t.Errorf("ReumannWitkamIndices() = %v; want %v", indices, []int{0, 3, 6})
+	}
+
+	result, error := testutils.CompareSlices(geometry.Decimate.ReumannWitkam(points, 0.1), expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestReumannWitkamInvalidInput tests the ReumannWitkamE function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestReumannWitkamInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	if _, err := geometry.Decimate.ReumannWitkamE([][]float64{{0, 0}, {1, 1}}, -1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("ReumannWitkamE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+	if _, err := geometry.Decimate.ReumannWitkamE([][]float64{{0, 0}, {1, 1, 1}}, 1); !errors.Is(err, decimate.ErrDimensionMismatch) {
+		t.Errorf("ReumannWitkamE() error = %v; want %v", err, decimate.ErrDimensionMismatch)
+	}
+}
+
+// TestReumannWitkamFixtures tests the ReumannWitkam function against the fixtures stored in the
+// testdata/reumann_witkam folder, for both 2D and 3D geometries.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestReumannWitkamFixtures(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		decimate *decimate.Decimate
+	}{
+		{"SingleLine2DFixedOffset", "single_line_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"SingleLine2DNoise", "single_line_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DFixedOffset", "polyline_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DNoise", "polyline_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline3DNoise", "polyline_3d_noise.json", geom3d.NewEuclid().Decimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/reumann_witkam/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.ReumannWitkam(data.Input, test.Epsilon)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with tolerance %v, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+				}
+			}
+		})
+	}
+}
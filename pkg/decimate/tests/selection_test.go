@@ -0,0 +1,107 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"reflect"
+	"testing"
+)
+
+// TestDouglasPeuckerIndices tests the DouglasPeuckerIndices function.
+// It checks that the returned positions select the same points as DouglasPeucker and can be used
+// to project a parallel attribute list.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestDouglasPeuckerIndices(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.1},
+		{2.0, -0.1},
+		{3.0, 5.0},
+		{4.0, 6.0},
+		{5.0, 7.0},
+		{6.0, 8.1},
+		{7.0, 9.0},
+	}
+	timestamps := []string{"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7"}
+
+	geometry := geom2d.NewEuclid()
+
+	indices := geometry.Decimate.DouglasPeuckerIndices(points, 0.5)
+	expected := []int{0, 2, 3, 7}
+	if !reflect.DeepEqual(indices, expected) {
+		t.Fatalf("DouglasPeuckerIndices() = %v; want %v", indices, expected)
+	}
+
+	result, error := testutils.CompareSlices(decimate.SelectPoints(points, indices), geometry.Decimate.DouglasPeucker(points, 0.5))
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+
+	var selected []string
+	for _, index := range indices {
+		selected = append(selected, timestamps[index])
+	}
+	if !reflect.DeepEqual(selected, []string{"t0", "t2", "t3", "t7"}) {
+		t.Errorf("Projected attributes = %v; want %v", selected, []string{"t0", "t2", "t3", "t7"})
+	}
+}
+
+// TestIndicesMatchFixtures tests the DouglasPeuckerIndices and VisvalingamWhyattIndices functions.
+// It checks that selecting the input points with the returned positions gives the fixture results.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestIndicesMatchFixtures(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		indices func(points [][]float64, threshold float64) []int
+	}{
+		{"DouglasPeucker", "../../../testdata/douglas_peucker/polyline_2d_noise.json", func(points [][]float64, threshold float64) []int {
+			return geom2d.NewEuclid().Decimate.DouglasPeuckerIndices(points, threshold)
+		}},
+		{"VisvalingamWhyatt", "../../../testdata/visvalingam_whyatt/polyline_2d_noise.json", func(points [][]float64, threshold float64) []int {
+			return geom2d.NewEuclid().Decimate.VisvalingamWhyattIndices(points, threshold)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader(tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				indices := tt.indices(data.Input, test.Epsilon)
+				points := decimate.SelectPoints(data.Input, indices)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with epsilon %v, %v, indices: %v", test.Epsilon, error, indices)
+				}
+			}
+		})
+	}
+}
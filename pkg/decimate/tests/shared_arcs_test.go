@@ -0,0 +1,206 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"math"
+	"testing"
+)
+
+// adjacentSquares returns two rings sharing a noisy vertical boundary, traversed in opposite
+// directions.
+//
+// Returns:
+//   - [][]float64: The ring on the left of the boundary.
+//   - [][]float64: The ring on the right of the boundary.
+func adjacentSquares() ([][]float64, [][]float64) {
+	var boundary [][]float64
+	for i := 0; i <= 40; i++ {
+		y := float64(i) / 4
+		boundary = append(boundary, []float64{10 + 0.6*math.Sin(3*y) + 0.3*math.Sin(7*y), y})
+	}
+	boundary[0] = []float64{10, 0}
+	boundary[len(boundary)-1] = []float64{10, 10}
+
+	left := [][]float64{{0, 0}}
+	left = append(left, boundary...)
+	left = append(left, []float64{0, 10}, []float64{0, 0})
+
+	right := [][]float64{{20, 0}, {20, 10}}
+	for i := len(boundary) - 1; i >= 0; i-- {
+		right = append(right, boundary[i])
+	}
+	right = append(right, []float64{20, 0})
+
+	return left, right
+}
+
+// boundaryPoints returns the points of a ring lying between the two squares, excluding corners.
+func boundaryPoints(ring [][]float64) map[[2]float64]bool {
+	result := make(map[[2]float64]bool)
+	for _, point := range ring {
+		if point[0] > 5 && point[0] < 15 {
+			result[[2]float64{point[0], point[1]}] = true
+		}
+	}
+	return result
+}
+
+// equalPointSets reports whether two sets of points are equal.
+func equalPointSets(a, b map[[2]float64]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for point := range a {
+		if !b[point] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestSimplifySharedAdjacentPolygons tests the SimplifyShared function.
+// It checks that the boundary shared by two adjacent rings is simplified the same way in both,
+// while simplifying them one at a time makes it diverge.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifySharedAdjacentPolygons(t *testing.T) {
+	left, right := adjacentSquares()
+	geometry := geom2d.NewEuclid()
+
+	separate := [][][]float64{geometry.Decimate.DouglasPeucker(left, 0.5), geometry.Decimate.DouglasPeucker(right, 0.5)}
+	if equalPointSets(boundaryPoints(separate[0]), boundaryPoints(separate[1])) {
+		t.Fatalf("The boundary simplified with DouglasPeucker does not diverge")
+	}
+
+	for _, threshold := range []float64{0.1, 0.5, 2.0} {
+		shared := geometry.Decimate.SimplifyShared([][][]float64{left, right}, threshold)
+		if !equalPointSets(boundaryPoints(shared[0]), boundaryPoints(shared[1])) {
+			t.Errorf("The boundary diverges with threshold %v: %v and %v", threshold, boundaryPoints(shared[0]), boundaryPoints(shared[1]))
+		}
+
+		for r, ring := range shared {
+			if !equalCoordinates(ring[0], ring[len(ring)-1]) {
+				t.Errorf("Ring %v is not closed with threshold %v: %v", r, threshold, ring)
+			}
+		}
+	}
+}
+
+// equalCoordinates reports whether two points have the same coordinates.
+func equalCoordinates(a, b []float64) bool {
+	return a[0] == b[0] && a[1] == b[1]
+}
+
+// TestSimplifySharedInnerArc tests the SimplifyShared function.
+// It checks that a line sharing only the middle of another line, in the opposite direction, is
+// simplified the same way as that part of the other line.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifySharedInnerArc(t *testing.T) {
+	var long [][]float64
+	for i := 0; i <= 60; i++ {
+		x := float64(i) / 2
+		long = append(long, []float64{x, math.Sin(x) + 0.2*math.Sin(5*x)})
+	}
+
+	var short [][]float64
+	for i := 45; i >= 15; i-- {
+		short = append(short, long[i])
+	}
+
+	geometry := geom2d.NewEuclid()
+	indices := geometry.Decimate.SimplifySharedIndices([][][]float64{long, short}, 0.3)
+
+	var inner []int
+	for _, index := range indices[0] {
+		if index >= 15 && index <= 45 {
+			inner = append(inner, index)
+		}
+	}
+	if len(inner) != len(indices[1]) {
+		t.Fatalf("SimplifySharedIndices() = %v; want the same points in both lines between 15 and 45", indices)
+	}
+	for k, index := range indices[1] {
+		if inner[len(inner)-1-k] != 45-index {
+			t.Fatalf("SimplifySharedIndices() = %v; want the same points in both lines between 15 and 45", indices)
+		}
+	}
+}
+
+// TestSimplifySharedIndependentLines tests the SimplifyShared function.
+// It checks that lines and rings sharing no point are simplified as DouglasPeucker does.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifySharedIndependentLines(t *testing.T) {
+	left, _ := adjacentSquares()
+	data, err := testutils.JSONTestDataReader("../../../testdata/douglas_peucker/polyline_2d_noise.json")
+	if err != nil {
+		t.Fatalf("Error while opening JSON file: %v", err)
+	}
+
+	geometry := geom2d.NewEuclid()
+	lines := [][][]float64{left, data.Input}
+
+	for _, threshold := range []float64{0.1, 0.5, 2.0} {
+		shared := geometry.Decimate.SimplifyShared(lines, threshold)
+		for l, line := range lines {
+			result, error := testutils.CompareSlices(shared[l], geometry.Decimate.DouglasPeucker(line, threshold))
+			if !result {
+				t.Errorf("The test failed for line %v with threshold %v, %v", l, threshold, error)
+			}
+		}
+	}
+}
+
+// TestSimplifySharedInvalidInput tests the SimplifySharedE function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplifySharedInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	if _, err := geometry.Decimate.SimplifySharedE(nil, 1.0); !errors.Is(err, decimate.ErrTooFewPoints) {
+		t.Errorf("SimplifySharedE() error = %v; want %v", err, decimate.ErrTooFewPoints)
+	}
+	if _, err := geometry.Decimate.SimplifySharedE([][][]float64{{{0, 0}, {1, 1}}}, -1.0); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("SimplifySharedE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+	if _, err := geometry.Decimate.SimplifySharedE([][][]float64{{{0, 0}, {1, 1}, {2, 0}}}, 1.0, decimate.WithPinned(1)); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("SimplifySharedE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+	if _, err := geometry.Decimate.SimplifySharedE([][][]float64{{{0, 0}, {1, 1}, {2, 0}}}, 1.0, decimate.WithCornerAngle(math.Pi/4)); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("SimplifySharedE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+}
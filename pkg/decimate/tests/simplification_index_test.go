@@ -0,0 +1,189 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// randomWalk generates a reproducible random polyline.
+//
+// Parameters:
+//   - seed (int64): The seed of the random generator.
+//   - size (int): The number of points of the polyline.
+//   - dimension (int): The dimension of the points.
+//
+// Returns:
+//   - [][]float64: The generated list of points.
+func randomWalk(seed int64, size, dimension int) [][]float64 {
+	generator := rand.New(rand.NewSource(seed))
+	points := make([][]float64, size)
+	current := make([]float64, dimension)
+	for i := range points {
+		for j := range current {
+			current[j] += generator.NormFloat64()
+		}
+		points[i] = append([]float64(nil), current...)
+	}
+	return points
+}
+
+// TestSimplificationIndexMatchesDouglasPeucker tests the At function of SimplificationIndex.
+// It checks that filtering the index gives the same result as running DouglasPeucker for a range
+// of thresholds, in 2D and 3D.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplificationIndexMatchesDouglasPeucker(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   [][]float64
+		decimate *decimate.Decimate
+	}{
+		{"RandomWalk2D", randomWalk(1, 500, 2), geom2d.NewEuclid().Decimate},
+		{"RandomWalk3D", randomWalk(2, 500, 3), geom3d.NewEuclid().Decimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := tt.decimate.SimplificationIndex(tt.points)
+			for _, threshold := range []float64{0.0, 0.1, 0.5, 1.0, 2.0, 5.0, 10.0, 50.0} {
+				expected := tt.decimate.DouglasPeuckerIndices(tt.points, threshold)
+				result := index.AtIndices(threshold)
+				if !reflect.DeepEqual(result, expected) {
+					t.Errorf("AtIndices(%v) = %v; want %v", threshold, result, expected)
+				}
+			}
+		})
+	}
+}
+
+// TestSimplificationIndexFixtures tests the At function of SimplificationIndex against the
+// fixtures stored in the testdata/douglas_peucker folder.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplificationIndexFixtures(t *testing.T) {
+	data, err := testutils.JSONTestDataReader("../../../testdata/douglas_peucker/polyline_2d_noise.json")
+	if err != nil {
+		t.Fatalf("Error while opening JSON file: %v", err)
+	}
+
+	index := geom2d.NewEuclid().Decimate.SimplificationIndex(data.Input)
+
+	for _, test := range data.Expected {
+		points := index.At(test.Epsilon)
+		result, error := testutils.CompareSlices(points, test.Data)
+		if !result {
+			t.Errorf("The test failed with epsilon %v, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+		}
+	}
+}
+
+// TestSimplificationIndexTopN tests the TopN function of SimplificationIndex.
+// It checks that the end points are always kept, that the vertices are returned by decreasing
+// significance and that collinear vertices are never returned.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplificationIndexTopN(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.5},
+		{2.0, 0.0},
+		{3.0, 3.0},
+		{4.0, 0.0},
+		{5.0, 0.0},
+		{6.0, 0.0},
+	}
+
+	index := geom2d.NewEuclid().Decimate.SimplificationIndex(points)
+
+	tests := []struct {
+		n        int
+		expected []int
+	}{
+		{0, []int{0, 6}},
+		{2, []int{0, 6}},
+		{3, []int{0, 3, 6}},
+		{4, []int{0, 2, 3, 6}},
+		{5, []int{0, 2, 3, 4, 6}},
+		{6, []int{0, 1, 2, 3, 4, 6}},
+		{100, []int{0, 1, 2, 3, 4, 6}},
+	}
+
+	for _, test := range tests {
+		result := index.TopNIndices(test.n)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("TopNIndices(%v) = %v; want %v", test.n, result, test.expected)
+		}
+	}
+
+	if index.Len() != 6 {
+		t.Errorf("Len() = %v; want %v", index.Len(), 6)
+	}
+	if !math.IsInf(index.Significance(0), 1) || index.Significance(5) != 0.0 {
+		t.Errorf("Significance() of end point and collinear vertex = %v, %v; want +Inf, 0", index.Significance(0), index.Significance(5))
+	}
+}
+
+// TestSimplificationIndexInvalidThreshold tests the At and AtIndices functions of
+// SimplificationIndex with invalid thresholds.
+// It checks that the error-returning forms return ErrNegativeThreshold instead of every point,
+// and that the panicking forms panic with it.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSimplificationIndexInvalidThreshold(t *testing.T) {
+	index := geom2d.NewEuclid().Decimate.SimplificationIndex(randomWalk(3, 50, 2))
+
+	for _, threshold := range []float64{-1, math.NaN()} {
+		if _, err := index.AtIndicesE(threshold); !errors.Is(err, decimate.ErrNegativeThreshold) {
+			t.Errorf("AtIndicesE(%v) error = %v; want %v", threshold, err, decimate.ErrNegativeThreshold)
+		}
+		if _, err := index.AtE(threshold); !errors.Is(err, decimate.ErrNegativeThreshold) {
+			t.Errorf("AtE(%v) error = %v; want %v", threshold, err, decimate.ErrNegativeThreshold)
+		}
+	}
+
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, decimate.ErrNegativeThreshold) {
+			t.Errorf("At panicked with %v, want %v", r, decimate.ErrNegativeThreshold)
+		}
+	}()
+
+	index.At(-1)
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"github.com/cenieto/decimate/pkg/trajectory"
+	"testing"
+)
+
+// squishECapacity is the capacity of the buffer used with the SQUISH-E fixtures.
+const squishECapacity = 32
+
+// stopTrack builds the trajectory of a vehicle driving at one unit per second along the x axis,
+// except for a stop from t=50 to t=60.
+//
+// Returns:
+//   - [][]float64: The points of the trajectory, with the timestamp as last coordinate.
+func stopTrack() [][]float64 {
+	points := make([][]float64, 111)
+	for i := range points {
+		x := float64(min(i, 50) + max(i-60, 0))
+		points[i] = []float64{x, 0, float64(i)}
+	}
+	return points
+}
+
+// timestamps returns the timestamps of a trajectory as integers.
+//
+// Parameters:
+//   - points ([][]float64): The points of the trajectory, with the timestamp as last coordinate.
+//
+// Returns:
+//   - []int: The timestamps.
+func timestamps(points [][]float64) []int {
+	times := make([]int, len(points))
+	for i, point := range points {
+		times[i] = int(point[len(point)-1])
+	}
+	return times
+}
+
+// TestSquishEStop tests the SquishEStream with a vehicle stopping on a straight road.
+// It checks that the points where the vehicle stops and starts again are kept when the buffer can
+// hold them, and that a smaller buffer keeps the end points and its capacity.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSquishEStop(t *testing.T) {
+	geometry := trajectory.NewEuclid(2)
+	expected := []int{0, 50, 60, 110}
+
+	for _, capacity := range []int{4, 5, 1000} {
+		stream, err := geometry.Decimate.NewSquishEStream(capacity, 0.5)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if times := timestamps(pushAll(t, stream, stopTrack())); !equalIndices(times, expected) {
+			t.Errorf("NewSquishEStream(%v) emitted %v; want %v", capacity, times, expected)
+		}
+	}
+
+	stream, err := geometry.Decimate.NewSquishEStream(3, 0.5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if times := timestamps(pushAll(t, stream, stopTrack())); len(times) != 3 || times[0] != 0 || times[2] != 110 {
+		t.Errorf("NewSquishEStream(3) emitted %v; want 3 points from 0 to 110", times)
+	}
+}
+
+// TestSquishEBoundedBuffer tests the SquishEStream with noisy input whose points all deviate more
+// than the maximum error.
+// It checks that Push never emits, that the flushed output has at most capacity points, keeps the
+// end points and is in order, and that a buffer holding the whole output keeps every removed point
+// within the maximum error of the segment joining the kept points around it.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSquishEBoundedBuffer(t *testing.T) {
+	geometry := trajectory.NewEuclid(2)
+	const maxError = 0.5
+
+	points := make([][]float64, 200)
+	for i := range points {
+		points[i] = []float64{float64(i), float64(i%4) + 0.3*float64(i%7), float64(i)}
+	}
+
+	for _, capacity := range []int{3, 10, 50, 1000} {
+		stream, err := geometry.Decimate.NewSquishEStream(capacity, maxError)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for _, point := range points {
+			emitted, err := stream.Push(point)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(emitted) != 0 {
+				t.Fatalf("Push() = %v; want no points before the flush", emitted)
+			}
+		}
+		kept := stream.Flush()
+
+		times := timestamps(kept)
+		if len(times) > capacity {
+			t.Errorf("NewSquishEStream(%v) emitted %v points; want at most %v", capacity, len(times), capacity)
+		}
+		if times[0] != 0 || times[len(times)-1] != len(points)-1 {
+			t.Fatalf("Emitted timestamps %v do not keep the end points", times)
+		}
+		for k := 1; k < len(times); k++ {
+			if times[k] <= times[k-1] {
+				t.Fatalf("Emitted timestamps %v are not increasing", times)
+			}
+			if len(times) == capacity {
+				continue
+			}
+			for i := times[k-1] + 1; i < times[k]; i++ {
+				if distance := geometry.SynchronizedDistanceCoordinates(points[i], kept[k-1], kept[k]); distance > maxError {
+					t.Errorf("Removed point %v is at %v from the output; want at most %v", i, distance, maxError)
+				}
+			}
+		}
+	}
+}
+
+// TestTrajectoryStreamsInvalidInput tests NewSquishEStream, NewDeadReckoningStream and their Push
+// methods with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestTrajectoryStreamsInvalidInput(t *testing.T) {
+	if _, err := geom2d.NewEuclid().Decimate.NewSquishEStream(4, 1); !errors.Is(err, decimate.ErrUnsupportedGeometry) {
+		t.Errorf("NewSquishEStream() error = %v; want %v", err, decimate.ErrUnsupportedGeometry)
+	}
+	if _, err := geom2d.NewEuclid().Decimate.NewDeadReckoningStream(1); !errors.Is(err, decimate.ErrUnsupportedGeometry) {
+		t.Errorf("NewDeadReckoningStream() error = %v; want %v", err, decimate.ErrUnsupportedGeometry)
+	}
+
+	geometry := trajectory.NewEuclid(2)
+	if _, err := geometry.Decimate.NewSquishEStream(2, 1); !errors.Is(err, decimate.ErrInvalidPointBudget) {
+		t.Errorf("NewSquishEStream() error = %v; want %v", err, decimate.ErrInvalidPointBudget)
+	}
+	if _, err := geometry.Decimate.NewSquishEStream(4, -1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("NewSquishEStream() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+	if _, err := geometry.Decimate.NewDeadReckoningStream(-1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("NewDeadReckoningStream() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+
+	squish, _ := geometry.Decimate.NewSquishEStream(4, 1)
+	reckoning, _ := geometry.Decimate.NewDeadReckoningStream(1)
+	for _, stream := range []historianStream{squish, reckoning} {
+		if _, err := stream.Push([]float64{0, 0}); !errors.Is(err, decimate.ErrDimensionMismatch) {
+			t.Errorf("Push() error = %v; want %v", err, decimate.ErrDimensionMismatch)
+		}
+		if _, err := stream.Push([]float64{0, 0, 5}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err := stream.Push([]float64{1, 0, 4}); !errors.Is(err, decimate.ErrNotMonotonic) {
+			t.Errorf("Push() error = %v; want %v", err, decimate.ErrNotMonotonic)
+		}
+	}
+}
+
+// TestSquishEFixtures tests the SquishEStream against the fixtures stored in the
+// testdata/squish_e folder, a vehicle trajectory alternating speeds and stops.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSquishEFixtures(t *testing.T) {
+	data, err := testutils.JSONTestDataReader("../../../testdata/squish_e/vehicle_stops.json")
+	if err != nil {
+		t.Fatalf("Error while opening JSON file: %v", err)
+	}
+
+	geometry := trajectory.NewEuclid(2)
+	for _, test := range data.Expected {
+		stream, err := geometry.Decimate.NewSquishEStream(squishECapacity, test.Epsilon)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		result, error := testutils.CompareSlices(pushAll(t, stream, data.Input), test.Data)
+		if !result {
+			t.Errorf("The test failed with epsilon %v, %v", test.Epsilon, error)
+		}
+	}
+}
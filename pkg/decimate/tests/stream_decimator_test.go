@@ -0,0 +1,271 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"context"
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+	"time"
+)
+
+// runStreamDecimator sends every point to a StreamDecimator and collects the kept points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to report unexpected errors.
+//   - stream (*decimate.StreamDecimator): The stream decimator.
+//   - points ([][]float64): The points to be simplified.
+//
+// Returns:
+//   - [][]float64: The kept points, in the order they are emitted.
+func runStreamDecimator(t *testing.T, stream *decimate.StreamDecimator, points [][]float64) [][]float64 {
+	input := make(chan []float64)
+	output := make(chan []float64)
+	done := make(chan error, 1)
+
+	go func() {
+		defer close(input)
+		for _, point := range points {
+			input <- point
+		}
+	}()
+	go func() { done <- stream.Run(context.Background(), input, output) }()
+
+	var kept [][]float64
+	for point := range output {
+		kept = append(kept, point)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return kept
+}
+
+// TestStreamDecimatorFixedWindow tests the StreamDecimator with fixed windows and no overlap.
+// It checks that the output is the batch Douglas-Peucker of consecutive windows sharing their
+// end points, including a last window shorter than the others.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestStreamDecimatorFixedWindow(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := wavyTrack(203)
+
+	for _, window := range []int{3, 25, 64, 500} {
+		stream, err := geometry.Decimate.NewStreamDecimator(0.5, decimate.FixedWindow, window, 0)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var expected [][]float64
+		start := 0
+		for ; start+window < len(points); start += window - 1 {
+			expected = append(expected, geometry.Decimate.DouglasPeucker(points[start:start+window], 0.5)...)
+			expected = expected[:len(expected)-1]
+		}
+		expected = append(expected, geometry.Decimate.DouglasPeucker(points[start:], 0.5)...)
+
+		result, err := testutils.CompareSlices(runStreamDecimator(t, stream, points), expected)
+		if !result {
+			t.Errorf("The test failed with window %v, %v", window, err)
+		}
+	}
+}
+
+// TestStreamDecimatorTolerance tests the StreamDecimator with overlapping and opening windows.
+// It checks that the end points are kept and that every removed point is within epsilon of the
+// segment joining the kept points around it.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestStreamDecimatorTolerance(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := wavyTrack(203)
+	const epsilon = 0.5
+
+	tests := []struct {
+		name    string
+		mode    decimate.WindowMode
+		window  int
+		overlap int
+	}{
+		{"Overlap", decimate.FixedWindow, 25, 10},
+		{"LargestOverlap", decimate.FixedWindow, 25, 23},
+		{"OpeningWindow", decimate.OpeningWindow, 500, 0},
+		{"BoundedOpeningWindow", decimate.OpeningWindow, 6, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := geometry.Decimate.NewStreamDecimator(epsilon, tt.mode, tt.window, tt.overlap)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			kept := runStreamDecimator(t, stream, points)
+
+			// The x coordinate of the track is half the position of the point.
+			indices := make([]int, len(kept))
+			for i, point := range kept {
+				indices[i] = int(point[0] * 2)
+			}
+			if indices[0] != 0 || indices[len(indices)-1] != len(points)-1 {
+				t.Fatalf("Kept positions %v do not keep the end points", indices)
+			}
+			for k := 1; k < len(indices); k++ {
+				if indices[k] <= indices[k-1] {
+					t.Fatalf("Kept positions %v are not increasing", indices)
+				}
+				if tt.mode == decimate.OpeningWindow && indices[k]-indices[k-1] >= tt.window {
+					t.Errorf("Kept positions %v and %v span more than the window", indices[k-1], indices[k])
+				}
+				for i := indices[k-1] + 1; i < indices[k]; i++ {
+					if distance := geometry.DistancePointLineCoordinates(points[i], kept[k-1], kept[k]); distance > epsilon {
+						t.Errorf("Removed point %v is at %v from the output; want at most %v", i, distance, epsilon)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestStreamDecimatorShortInput tests the StreamDecimator with inputs shorter than a window.
+// It checks that every point is kept when there are fewer than three.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestStreamDecimatorShortInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := [][]float64{{0, 0}, {1, 5}}
+
+	for _, mode := range []decimate.WindowMode{decimate.FixedWindow, decimate.OpeningWindow} {
+		stream, err := geometry.Decimate.NewStreamDecimator(0.5, mode, 10, 0)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for size := range len(points) + 1 {
+			if kept := runStreamDecimator(t, stream, points[:size]); len(kept) != size {
+				t.Errorf("Mode %v kept %v of %v points; want all of them", mode, kept, size)
+			}
+		}
+	}
+}
+
+// TestStreamDecimatorCancellation tests the Run method with a cancelled context.
+// It checks that a run blocked by a consumer that stops reading, or by an input that stops
+// sending, returns the error of the context and closes the output.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestStreamDecimatorCancellation(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	stream, err := geometry.Decimate.NewStreamDecimator(0.5, decimate.OpeningWindow, 10, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, name := range []string{"BlockedOutput", "BlockedInput"} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			input := make(chan []float64, 1)
+			output := make(chan []float64)
+			done := make(chan error, 1)
+
+			// The first point is emitted at once, blocking on the output until it is read.
+			input <- []float64{0, 0}
+			go func() { done <- stream.Run(ctx, input, output) }()
+			if name == "BlockedInput" {
+				<-output
+			}
+			cancel()
+
+			select {
+			case err := <-done:
+				if !errors.Is(err, context.Canceled) {
+					t.Errorf("Run() error = %v; want %v", err, context.Canceled)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Run() did not return after the cancellation")
+			}
+			if _, open := <-output; open {
+				t.Errorf("Run() did not close the output")
+			}
+		})
+	}
+}
+
+// TestStreamDecimatorInvalidInput tests NewStreamDecimator and Run with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestStreamDecimatorInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		name     string
+		epsilon  float64
+		window   int
+		overlap  int
+		expected error
+	}{
+		{"NegativeEpsilon", -1, 10, 0, decimate.ErrNegativeThreshold},
+		{"SmallWindow", 0.5, 2, 0, decimate.ErrInvalidWindow},
+		{"NegativeOverlap", 0.5, 10, -1, decimate.ErrInvalidWindow},
+		{"LargeOverlap", 0.5, 10, 9, decimate.ErrInvalidWindow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := geometry.Decimate.NewStreamDecimator(tt.epsilon, decimate.FixedWindow, tt.window, tt.overlap); !errors.Is(err, tt.expected) {
+				t.Errorf("NewStreamDecimator() error = %v; want %v", err, tt.expected)
+			}
+		})
+	}
+	if _, err := geometry.Decimate.NewStreamDecimator(0.5, decimate.WindowMode(-1), 10, 0); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("NewStreamDecimator() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+	if _, err := geometry.Decimate.NewStreamDecimator(0.5, decimate.OpeningWindow+1, 10, 0); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("NewStreamDecimator() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+
+	stream, err := geometry.Decimate.NewStreamDecimator(0.5, decimate.FixedWindow, 10, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	input := make(chan []float64, 2)
+	input <- []float64{0, 0}
+	input <- []float64{1, 1, 1}
+	close(input)
+	output := make(chan []float64)
+	if err := stream.Run(context.Background(), input, output); !errors.Is(err, decimate.ErrDimensionMismatch) {
+		t.Errorf("Run() error = %v; want %v", err, decimate.ErrDimensionMismatch)
+	}
+}
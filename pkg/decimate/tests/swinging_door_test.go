@@ -0,0 +1,228 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geomnd"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"math"
+	"testing"
+)
+
+// historianStream is the push interface shared by SwingingDoorStream and DeadbandStream.
+type historianStream interface {
+	Push(point []float64) ([][]float64, error)
+	Flush() [][]float64
+}
+
+// pushAll gives every point of a time series to a stream and flushes it.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to report unexpected errors.
+//   - stream (historianStream): The stream.
+//   - points ([][]float64): The time series.
+//
+// Returns:
+//   - [][]float64: The points archived by the stream.
+func pushAll(t *testing.T, stream historianStream, points [][]float64) [][]float64 {
+	var archived [][]float64
+	for _, point := range points {
+		emitted, err := stream.Push(point)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		archived = append(archived, emitted...)
+	}
+	return append(archived, stream.Flush()...)
+}
+
+// TestSwingingDoorRamp tests the SwingingDoorIndices function.
+// It checks that a ramp is archived as its end points, and that a change of slope larger than the
+// deviation archives the point where it happens.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestSwingingDoorRamp(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		name     string
+		points   [][]float64
+		expected []int
+	}{
+		{"Ramp", [][]float64{{0, 0}, {1, 1.1}, {2, 1.9}, {3, 3}, {4, 4.05}, {5, 5}}, []int{0, 5}},
+		{"Knee", [][]float64{{0, 0}, {1, 1}, {2, 2}, {3, 3}, {4, 3}, {5, 3}, {6, 3}}, []int{0, 3, 6}},
+		{"RepeatedTime", [][]float64{{0, 0}, {1, 0}, {1, 5}, {2, 5}}, []int{0, 1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if indices := geometry.Decimate.SwingingDoorIndices(tt.points, 0.2); !equalIndices(indices, tt.expected) {
+				t.Errorf("SwingingDoorIndices() = %v; want %v", indices, tt.expected)
+			}
+		})
+	}
+}
+
+// TestHistorianStreams tests SwingingDoorStream and DeadbandStream against their batch forms.
+// It checks that pushing every point and flushing archives the points of the batch form, with and
+// without a maximum interval, and that the interval is kept while points arrive often enough.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestHistorianStreams(t *testing.T) {
+	data, err := testutils.JSONTestDataReader("../../../testdata/swinging_door/process_tag.json")
+	if err != nil {
+		t.Fatalf("Error while opening JSON file: %v", err)
+	}
+	d := *geom2d.NewEuclid().Decimate
+
+	tests := []struct {
+		name   string
+		batch  func(points [][]float64, opts ...decimate.Option) ([][]float64, error)
+		stream func(opts ...decimate.Option) (historianStream, error)
+	}{
+		{"SwingingDoor", func(points [][]float64, opts ...decimate.Option) ([][]float64, error) {
+			return d.SwingingDoorE(points, 0.5, opts...)
+		}, func(opts ...decimate.Option) (historianStream, error) {
+			return d.NewSwingingDoorStream(0.5, opts...)
+		}},
+		{"Deadband", func(points [][]float64, opts ...decimate.Option) ([][]float64, error) {
+			return d.DeadbandE(points, 0.5, opts...)
+		}, func(opts ...decimate.Option) (historianStream, error) {
+			return d.NewDeadbandStream(0.5, opts...)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, opts := range [][]decimate.Option{nil, {decimate.WithMaxInterval(10)}} {
+				expected, err := tt.batch(data.Input, opts...)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				stream, err := tt.stream(opts...)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				result, error := testutils.CompareSlices(pushAll(t, stream, data.Input), expected)
+				if !result {
+					t.Errorf("The stream differs from the batch form with options %v, %v", opts, error)
+				}
+			}
+
+			archived, _ := tt.batch(data.Input, decimate.WithMaxInterval(10))
+			for i := 1; i < len(archived); i++ {
+				// Time steps of the series are at most two units long.
+				if gap := archived[i][0] - archived[i-1][0]; gap > 10+2 {
+					t.Errorf("Archived points at %v and %v are %v apart", archived[i-1][0], archived[i][0], gap)
+				}
+			}
+		})
+	}
+}
+
+// TestHistorianInvalidInput tests the swinging door and deadband compressors with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking, and that a stream keeps
+// working after rejecting a point.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestHistorianInvalidInput(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	if _, err := geometry.Decimate.SwingingDoorE([][]float64{{0, 0}, {1, 1}}, -1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("SwingingDoorE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+	if _, err := geometry.Decimate.DeadbandE([][]float64{{0, 0}, {1, 1}}, 1, decimate.WithMaxInterval(math.NaN())); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("DeadbandE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+	if _, err := geometry.Decimate.DeadbandE([][]float64{{1, 0}, {0, 1}}, 1); !errors.Is(err, decimate.ErrNotMonotonic) {
+		t.Errorf("DeadbandE() error = %v; want %v", err, decimate.ErrNotMonotonic)
+	}
+	if _, err := geomnd.NewEuclid(1).Decimate.NewSwingingDoorStream(1); !errors.Is(err, decimate.ErrUnsupportedDimension) {
+		t.Errorf("NewSwingingDoorStream() error = %v; want %v", err, decimate.ErrUnsupportedDimension)
+	}
+
+	stream, err := geometry.Decimate.NewSwingingDoorStream(1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := stream.Push([]float64{5, 0}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := stream.Push([]float64{4, 0}); !errors.Is(err, decimate.ErrNotMonotonic) {
+		t.Errorf("Push() error = %v; want %v", err, decimate.ErrNotMonotonic)
+	}
+	if _, err := stream.Push([]float64{6, math.Inf(1)}); !errors.Is(err, decimate.ErrNonFiniteCoordinate) {
+		t.Errorf("Push() error = %v; want %v", err, decimate.ErrNonFiniteCoordinate)
+	}
+	if _, err := stream.Push([]float64{6, 0}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if flushed := stream.Flush(); len(flushed) != 1 || flushed[0][0] != 6 {
+		t.Errorf("Flush() = %v; want [[6 0]]", flushed)
+	}
+}
+
+// TestHistorianFixtures tests the SwingingDoor and Deadband functions against the fixtures stored
+// in the testdata/swinging_door and testdata/deadband folders, where epsilon is the deviation or
+// the deadband.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestHistorianFixtures(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+
+	tests := []struct {
+		name       string
+		fixture    string
+		compressor func(points [][]float64, threshold float64, opts ...decimate.Option) [][]float64
+	}{
+		{"SwingingDoor", "../../../testdata/swinging_door/process_tag.json", geometry.Decimate.SwingingDoor},
+		{"Deadband", "../../../testdata/deadband/process_tag.json", geometry.Decimate.Deadband},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader(tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.compressor(data.Input, test.Epsilon)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with epsilon %v, %v", test.Epsilon, error)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"github.com/cenieto/decimate/pkg/trajectory"
+	"testing"
+)
+
+// TestTDTRStop tests the TDTRIndices function with a vehicle stopping on a straight road.
+// It checks that Douglas-Peucker collapses the stop into the straight line, while TDTR keeps the
+// points where the vehicle stops and starts again.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestTDTRStop(t *testing.T) {
+	points := stopTrack()
+	geometry := trajectory.NewEuclid(2)
+
+	if indices := geometry.Decimate.DouglasPeuckerIndices(points, 1.0); !equalIndices(indices, []int{0, 110}) {
+		t.Errorf("DouglasPeuckerIndices() = %v; want %v", indices, []int{0, 110})
+	}
+	expected := []int{0, 50, 60, 110}
+	if indices := geometry.Decimate.TDTRIndices(points, 1.0); !equalIndices(indices, expected) {
+		t.Errorf("TDTRIndices() = %v; want %v", indices, expected)
+	}
+	if indices := geometry.Decimate.TDTRIndices(points, 1.0, decimate.WithPinned(30)); !equalIndices(indices, []int{0, 30, 50, 60, 110}) {
+		t.Errorf("TDTRIndices() = %v; want the pinned point 30 along with %v", indices, expected)
+	}
+}
+
+// TestTDTRInvalidInput tests the TDTRE function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestTDTRInvalidInput(t *testing.T) {
+	if _, err := geom2d.NewEuclid().Decimate.TDTRE([][]float64{{0, 0}, {1, 1}}, 1); !errors.Is(err, decimate.ErrUnsupportedGeometry) {
+		t.Errorf("TDTRE() error = %v; want %v", err, decimate.ErrUnsupportedGeometry)
+	}
+
+	geometry := trajectory.NewEuclid(2)
+	if _, err := geometry.Decimate.TDTRE([][]float64{{0, 0, 1}, {1, 1, 0}}, 1); !errors.Is(err, decimate.ErrNotMonotonic) {
+		t.Errorf("TDTRE() error = %v; want %v", err, decimate.ErrNotMonotonic)
+	}
+	if _, err := geometry.Decimate.TDTRE([][]float64{{0, 0}, {1, 1}}, 1); !errors.Is(err, decimate.ErrDimensionMismatch) {
+		t.Errorf("TDTRE() error = %v; want %v", err, decimate.ErrDimensionMismatch)
+	}
+	if _, err := geometry.Decimate.TDTRE([][]float64{{0, 0, 0}, {1, 1, 1}}, -1); !errors.Is(err, decimate.ErrNegativeThreshold) {
+		t.Errorf("TDTRE() error = %v; want %v", err, decimate.ErrNegativeThreshold)
+	}
+}
+
+// TestTDTRFixtures tests the TDTR function against the fixtures stored in the testdata/tdtr
+// folder, a vehicle trajectory alternating speeds and stops.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestTDTRFixtures(t *testing.T) {
+	data, err := testutils.JSONTestDataReader("../../../testdata/tdtr/vehicle_stops.json")
+	if err != nil {
+		t.Fatalf("Error while opening JSON file: %v", err)
+	}
+
+	geometry := trajectory.NewEuclid(2)
+	for _, test := range data.Expected {
+		points := geometry.Decimate.TDTR(data.Input, test.Epsilon)
+		result, error := testutils.CompareSlices(points, test.Data)
+		if !result {
+			t.Errorf("The test failed with epsilon %v, %v", test.Epsilon, error)
+		}
+	}
+}
+
+// TestUnknownDistanceMode tests the Douglas-Peucker family with a distance mode that is not one of
+// the DistanceMode constants, such as the value TDTR used to pass internally.
+// It checks that ErrInvalidParameter is returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestUnknownDistanceMode(t *testing.T) {
+	geometry := geom2d.NewEuclid()
+	points := wavyTrack(20)
+
+	for _, mode := range []decimate.DistanceMode{2, -1} {
+		option := decimate.WithDistance(mode)
+		if _, err := geometry.Decimate.DouglasPeuckerIndicesE(points, 0.5, option); !errors.Is(err, decimate.ErrInvalidParameter) {
+			t.Errorf("DouglasPeuckerIndicesE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+		}
+		if _, err := geometry.Decimate.DouglasPeuckerNIndicesE(points, 5, option); !errors.Is(err, decimate.ErrInvalidParameter) {
+			t.Errorf("DouglasPeuckerNIndicesE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+		}
+		if _, err := geometry.Decimate.OptimalIndicesE(points, 0.5, option); !errors.Is(err, decimate.ErrInvalidParameter) {
+			t.Errorf("OptimalIndicesE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+		}
+		if _, err := geometry.Decimate.SimplificationIndexE(points, option); !errors.Is(err, decimate.ErrInvalidParameter) {
+			t.Errorf("SimplificationIndexE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+		}
+	}
+}
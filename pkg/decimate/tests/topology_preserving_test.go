@@ -0,0 +1,199 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// properlyCross reports whether two segments cross at a single point inside both of them.
+func properlyCross(a, b, p, q []float64) bool {
+	turn := func(a, b, c []float64) float64 {
+		return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+	}
+	return turn(p, q, a)*turn(p, q, b) < 0 && turn(a, b, p)*turn(a, b, q) < 0
+}
+
+// crossings counts the pairs of non-adjacent segments of a collection of lines that cross.
+func crossings(lines [][][]float64) int {
+	count := 0
+	for l, line := range lines {
+		for i := 1; i < len(line); i++ {
+			for m := l; m < len(lines); m++ {
+				start := 1
+				if m == l {
+					start = i + 2
+				}
+				for j := start; j < len(lines[m]); j++ {
+					if properlyCross(line[i-1], line[i], lines[m][j-1], lines[m][j]) {
+						count++
+					}
+				}
+			}
+		}
+	}
+	return count
+}
+
+// TestTopologyPreservingSelfIntersection tests the TopologyPreserving function.
+// It checks a line whose Douglas-Peucker simplification crosses itself, and that the topology
+// preserving simplification keeps the points needed to avoid it.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestTopologyPreservingSelfIntersection(t *testing.T) {
+	points := [][]float64{
+		{6.0, 5.0},
+		{5.0, 2.0},
+		{2.0, 5.0},
+		{1.0, 4.0},
+		{6.0, 0.0},
+		{3.0, 4.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	simplified := geometry.Decimate.DouglasPeucker(points, 1.5)
+	if crossings([][][]float64{simplified}) == 0 {
+		t.Fatalf("DouglasPeucker() = %v; want a line crossing itself", simplified)
+	}
+
+	lines := geometry.Decimate.TopologyPreserving([][][]float64{points}, 1.5)
+	if count := crossings(lines); count != 0 {
+		t.Errorf("TopologyPreserving() = %v; crosses itself %v times", lines, count)
+	}
+}
+
+// TestTopologyPreservingSideOfNeighbour tests the TopologyPreserving function.
+// It checks that a bump is kept when removing it would move a neighbouring line to its other
+// side, and removed when there is no neighbouring line.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestTopologyPreservingSideOfNeighbour(t *testing.T) {
+	border := [][]float64{{0.0, 0.0}, {5.0, 1.0}, {10.0, 0.0}}
+	neighbour := [][]float64{{4.0, 0.5}, {6.0, 0.5}}
+
+	geometry := geom2d.NewEuclid()
+
+	alone := geometry.Decimate.TopologyPreservingIndices([][][]float64{border}, 2.0)
+	if len(alone[0]) != 2 {
+		t.Errorf("TopologyPreservingIndices() = %v; want %v", alone, [][]int{{0, 2}})
+	}
+
+	together := geometry.Decimate.TopologyPreservingIndices([][][]float64{border, neighbour}, 2.0)
+	if len(together[0]) != 3 || len(together[1]) != 2 {
+		t.Errorf("TopologyPreservingIndices() = %v; want %v", together, [][]int{{0, 1, 2}, {0, 1}})
+	}
+}
+
+// TestTopologyPreservingWaves tests the TopologyPreserving function on close parallel waves.
+// It checks that the simplified waves never cross, while Douglas-Peucker makes them cross, and
+// that they still keep the points kept by DouglasPeucker on every wave alone.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestTopologyPreservingWaves(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+
+	var lines [][][]float64
+	for k := 0; k < 5; k++ {
+		var line [][]float64
+		for i := 0; i <= 400; i++ {
+			x := 20 * float64(i) / 400
+			line = append(line, []float64{x, 0.5*float64(k) + math.Sin(x+0.3*float64(k)) + 0.02*(random.Float64()-0.5)})
+		}
+		lines = append(lines, line)
+	}
+	if crossings(lines) != 0 {
+		t.Fatalf("The input waves cross")
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	greedy := make([][][]float64, len(lines))
+	for l, line := range lines {
+		greedy[l] = geometry.Decimate.DouglasPeucker(line, 1.0)
+	}
+	if crossings(greedy) == 0 {
+		t.Fatalf("The waves simplified with DouglasPeucker do not cross")
+	}
+
+	for _, threshold := range []float64{0.1, 0.5, 1.0} {
+		indices := geometry.Decimate.TopologyPreservingIndices(lines, threshold)
+
+		simplified := make([][][]float64, len(lines))
+		for l, line := range lines {
+			simplified[l] = decimate.SelectPoints(line, indices[l])
+
+			kept := make(map[int]bool)
+			for _, index := range indices[l] {
+				kept[index] = true
+			}
+			for _, index := range geometry.Decimate.DouglasPeuckerIndices(line, threshold) {
+				if !kept[index] {
+					t.Errorf("Point %v of wave %v is kept by DouglasPeucker with threshold %v", index, l, threshold)
+				}
+			}
+		}
+
+		if count := crossings(simplified); count != 0 {
+			t.Errorf("The simplified waves cross %v times with threshold %v", count, threshold)
+		}
+	}
+}
+
+// TestTopologyPreservingInvalidInput tests the TopologyPreservingE function with invalid inputs.
+// It checks that the sentinel errors are returned instead of panicking.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestTopologyPreservingInvalidInput(t *testing.T) {
+	lines := [][][]float64{{{0, 0, 0}, {1, 1, 1}}}
+	if _, err := geom3d.NewEuclid().Decimate.TopologyPreservingE(lines, 1.0); !errors.Is(err, decimate.ErrUnsupportedDimension) {
+		t.Errorf("TopologyPreservingE() error = %v; want %v", err, decimate.ErrUnsupportedDimension)
+	}
+
+	geometry := geom2d.NewEuclid()
+	if _, err := geometry.Decimate.TopologyPreservingE(nil, 1.0); !errors.Is(err, decimate.ErrTooFewPoints) {
+		t.Errorf("TopologyPreservingE() error = %v; want %v", err, decimate.ErrTooFewPoints)
+	}
+	if _, err := geometry.Decimate.TopologyPreservingE([][][]float64{{{0, 0}, {1, 1}}, {{0, 0}}}, 1.0); !errors.Is(err, decimate.ErrTooFewPoints) {
+		t.Errorf("TopologyPreservingE() error = %v; want %v", err, decimate.ErrTooFewPoints)
+	}
+	if _, err := geometry.Decimate.TopologyPreservingE([][][]float64{{{0, 0}, {1, 1}, {2, 0}}}, 1.0, decimate.WithPinned(1)); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("TopologyPreservingE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+	if _, err := geometry.Decimate.TopologyPreservingE([][][]float64{{{0, 0}, {1, 1}, {2, 0}}}, 1.0, decimate.WithCornerAngle(math.Pi/4)); !errors.Is(err, decimate.ErrInvalidParameter) {
+		t.Errorf("TopologyPreservingE() error = %v; want %v", err, decimate.ErrInvalidParameter)
+	}
+}
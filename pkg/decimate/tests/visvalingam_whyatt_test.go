@@ -0,0 +1,152 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tests
+
+import (
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/geom3d"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"testing"
+)
+
+// TestVisvalingamWhyattOnlyTwoPointsInput tests the VisvalingamWhyatt function.
+// It checks if the function returns the same input when the input has only two points.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestVisvalingamWhyattOnlyTwoPointsInput(t *testing.T) {
+	points := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+	expected := [][]float64{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	points = geometry.Decimate.VisvalingamWhyatt(points, 0.1)
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestVisvalingamWhyattAllPointsInsideThreshold tests the VisvalingamWhyatt function.
+// It checks if the function removes every interior point when all of them are collinear.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestVisvalingamWhyattAllPointsInsideThreshold(t *testing.T) {
+	points := [][]float64{
+		{1.0, 2.0},
+		{2.0, 2.0},
+		{3.0, 2.0},
+		{4.0, 2.0},
+		{5.0, 2.0},
+	}
+
+	expected := [][]float64{
+		{1.0, 2.0},
+		{5.0, 2.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	points = geometry.Decimate.VisvalingamWhyatt(points, 0.1)
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestVisvalingamWhyattEffectiveArea tests the VisvalingamWhyatt function.
+// It checks that only the vertices whose triangle area is below the minimum area are removed.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestVisvalingamWhyattEffectiveArea(t *testing.T) {
+	points := [][]float64{
+		{0.0, 0.0},
+		{1.0, 0.1},
+		{2.0, 0.0},
+		{3.0, 2.0},
+		{4.0, 0.0},
+	}
+
+	expected := [][]float64{
+		{0.0, 0.0},
+		{2.0, 0.0},
+		{3.0, 2.0},
+		{4.0, 0.0},
+	}
+
+	geometry := geom2d.NewEuclid()
+
+	points = geometry.Decimate.VisvalingamWhyatt(points, 0.5)
+	result, error := testutils.CompareSlices(points, expected)
+	if !result {
+		t.Errorf("The test failed, %v", error)
+	}
+}
+
+// TestVisvalingamWhyattFixtures tests the VisvalingamWhyatt function against the fixtures
+// stored in the testdata/visvalingam_whyatt folder, for both 2D and 3D geometries.
+//
+// Parameters:
+//   - t (*testing.T): A testing object used to run tests and check for failures.
+//
+// Returns:
+//   - None
+func TestVisvalingamWhyattFixtures(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		decimate *decimate.Decimate
+	}{
+		{"SingleLine2DFixedOffset", "single_line_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"SingleLine2DNoise", "single_line_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DFixedOffset", "polyline_2d_fixed_offset.json", geom2d.NewEuclid().Decimate},
+		{"Polyline2DNoise", "polyline_2d_noise.json", geom2d.NewEuclid().Decimate},
+		{"Polyline3DNoise", "polyline_3d_noise.json", geom3d.NewEuclid().Decimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := testutils.JSONTestDataReader("../../../testdata/visvalingam_whyatt/" + tt.fixture)
+			if err != nil {
+				t.Fatalf("Error while opening JSON file: %v", err)
+			}
+
+			for _, test := range data.Expected {
+				points := tt.decimate.VisvalingamWhyatt(data.Input, test.Epsilon)
+				result, error := testutils.CompareSlices(points, test.Data)
+				if !result {
+					t.Errorf("The test failed with minimum area %v, %v, expected: %v\n, result: %v", test.Epsilon, error, test.Data, points)
+				}
+			}
+		})
+	}
+}
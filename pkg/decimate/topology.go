@@ -0,0 +1,295 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"fmt"
+	"math"
+)
+
+// vertexRef identifies a vertex of a collection of lines.
+type vertexRef struct {
+	line  int // Position of the line in the collection
+	index int // Position of the vertex in the line
+}
+
+// cell identifies a cell of a spatial grid.
+type cell [2]int
+
+// topology is a uniform grid over a collection of 2D lines, indexing their original vertices and
+// the segments of their current simplification, used to find the shortcuts that would change the
+// topology of the collection.
+type topology struct {
+	lines    [][][]float64              // The original lines
+	minX     float64                    // Smallest x coordinate of the lines
+	minY     float64                    // Smallest y coordinate of the lines
+	size     float64                    // Length of the side of a cell
+	vertices map[cell][]vertexRef       // Original vertices, by cell
+	segments map[cell]map[vertexRef]int // Simplified segments, from their start to their end position, by cell
+}
+
+// newTopology builds the spatial grid of a collection of lines and their current simplification.
+// Cells are sized so that every cell holds about one vertex on average.
+//
+// Parameters:
+//   - lines ([][][]float64): The original lines.
+//   - keep ([][]bool): The kept points of every line, by position.
+//
+// Returns:
+//   - *topology: The spatial grid.
+func newTopology(lines [][][]float64, keep [][]bool) *topology {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	count := 0
+	for _, points := range lines {
+		for _, point := range points {
+			minX, maxX = math.Min(minX, point[0]), math.Max(maxX, point[0])
+			minY, maxY = math.Min(minY, point[1]), math.Max(maxY, point[1])
+		}
+		count += len(points)
+	}
+
+	size := math.Max(maxX-minX, maxY-minY) / math.Ceil(math.Sqrt(float64(count)))
+	if size == 0 {
+		size = 1
+	}
+
+	t := &topology{
+		lines:    lines,
+		minX:     minX,
+		minY:     minY,
+		size:     size,
+		vertices: make(map[cell][]vertexRef),
+		segments: make(map[cell]map[vertexRef]int),
+	}
+
+	for l, points := range lines {
+		for i, point := range points {
+			key := t.cellOf(point)
+			t.vertices[key] = append(t.vertices[key], vertexRef{line: l, index: i})
+		}
+
+		indices := keptIndices(keep[l])
+		for k := 1; k < len(indices); k++ {
+			t.addSegment(l, indices[k-1], indices[k])
+		}
+	}
+
+	return t
+}
+
+// cellOf returns the cell containing a point.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point.
+//
+// Returns:
+//   - cell: The cell containing the point.
+func (t *topology) cellOf(point []float64) cell {
+	return cell{int(math.Floor((point[0] - t.minX) / t.size)), int(math.Floor((point[1] - t.minY) / t.size))}
+}
+
+// cells calls visit with every cell overlapping the bounding box of a list of points.
+//
+// Parameters:
+//   - points ([][]float64): The list of points.
+//   - visit (func(cell)): The function called with every cell.
+func (t *topology) cells(points [][]float64, visit func(cell)) {
+	low, high := t.cellOf(points[0]), t.cellOf(points[0])
+	for _, point := range points[1:] {
+		key := t.cellOf(point)
+		low = cell{min(low[0], key[0]), min(low[1], key[1])}
+		high = cell{max(high[0], key[0]), max(high[1], key[1])}
+	}
+
+	for x := low[0]; x <= high[0]; x++ {
+		for y := low[1]; y <= high[1]; y++ {
+			visit(cell{x, y})
+		}
+	}
+}
+
+// addSegment indexes a simplified segment in every cell overlapping its bounding box.
+//
+// Parameters:
+//   - line (int): The position of the line in the collection.
+//   - first (int): The position of the start of the segment in the line.
+//   - last (int): The position of the end of the segment in the line.
+func (t *topology) addSegment(line, first, last int) {
+	points := t.lines[line]
+	t.cells([][]float64{points[first], points[last]}, func(key cell) {
+		if t.segments[key] == nil {
+			t.segments[key] = make(map[vertexRef]int)
+		}
+		t.segments[key][vertexRef{line: line, index: first}] = last
+	})
+}
+
+// split replaces a simplified segment by the two segments joining its ends to one of the points
+// between them.
+//
+// Parameters:
+//   - line (int): The position of the line in the collection.
+//   - first (int): The position of the start of the segment in the line.
+//   - index (int): The position of the point splitting the segment.
+//   - last (int): The position of the end of the segment in the line.
+func (t *topology) split(line, first, index, last int) {
+	points := t.lines[line]
+	t.cells([][]float64{points[first], points[last]}, func(key cell) {
+		delete(t.segments[key], vertexRef{line: line, index: first})
+	})
+	t.addSegment(line, first, index)
+	t.addSegment(line, index, last)
+}
+
+// conflicts reports whether the simplified segment replacing the points between first and last
+// changes the topology of the collection, either because it crosses or touches another
+// simplified segment, or because an original vertex lies in the area enclosed by the segment and
+// the points it replaces, and so switches sides of the line.
+//
+// Parameters:
+//   - line (int): The position of the line in the collection.
+//   - first (int): The position of the start of the segment in the line.
+//   - last (int): The position of the end of the segment in the line.
+//
+// Returns:
+//   - bool: True if the segment changes the topology of the collection.
+func (t *topology) conflicts(line, first, last int) bool {
+	points := t.lines[line]
+	start, end := points[first], points[last]
+	self := vertexRef{line: line, index: first}
+
+	conflict := false
+	t.cells([][]float64{start, end}, func(key cell) {
+		for ref, other := range t.segments[key] {
+			if conflict || ref == self {
+				continue
+			}
+			conflict = segmentsConflict(start, end, t.lines[ref.line][ref.index], t.lines[ref.line][other])
+		}
+	})
+	if conflict {
+		return true
+	}
+
+	chain := points[first : last+1]
+	t.cells(chain, func(key cell) {
+		for _, ref := range t.vertices[key] {
+			if conflict || (ref.line == line && ref.index >= first && ref.index <= last) {
+				continue
+			}
+			point := t.lines[ref.line][ref.index]
+			if equalCoordinates(point, start) || equalCoordinates(point, end) {
+				continue
+			}
+			conflict = pointInRing(point, chain)
+		}
+	})
+	return conflict
+}
+
+// orientation returns the sign of the turn from a to b to c, positive when counterclockwise,
+// negative when clockwise and zero when the points are collinear.
+//
+// Parameters:
+//   - a ([]float64): The coordinates of the first point.
+//   - b ([]float64): The coordinates of the second point.
+//   - c ([]float64): The coordinates of the third point.
+//
+// Returns:
+//   - float64: Twice the signed area of the triangle.
+func orientation(a, b, c []float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+// touches reports whether a point collinear with a segment lies on it, other than at its ends.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point.
+//   - start ([]float64): The coordinates of the start of the segment.
+//   - end ([]float64): The coordinates of the end of the segment.
+//   - turn (float64): The orientation of the point with respect to the segment.
+//
+// Returns:
+//   - bool: True if the point lies on the segment and is not one of its ends.
+func touches(point, start, end []float64, turn float64) bool {
+	if turn != 0 || equalCoordinates(point, start) || equalCoordinates(point, end) {
+		return false
+	}
+	return point[0] >= math.Min(start[0], end[0]) && point[0] <= math.Max(start[0], end[0]) &&
+		point[1] >= math.Min(start[1], end[1]) && point[1] <= math.Max(start[1], end[1])
+}
+
+// segmentsConflict reports whether two segments cross or touch, other than at a shared end.
+//
+// Parameters:
+//   - a ([]float64): The coordinates of the start of the first segment.
+//   - b ([]float64): The coordinates of the end of the first segment.
+//   - p ([]float64): The coordinates of the start of the second segment.
+//   - q ([]float64): The coordinates of the end of the second segment.
+//
+// Returns:
+//   - bool: True if the segments cross or touch.
+func segmentsConflict(a, b, p, q []float64) bool {
+	turnA, turnB := orientation(p, q, a), orientation(p, q, b)
+	turnP, turnQ := orientation(a, b, p), orientation(a, b, q)
+
+	if ((turnA > 0 && turnB < 0) || (turnA < 0 && turnB > 0)) && ((turnP > 0 && turnQ < 0) || (turnP < 0 && turnQ > 0)) {
+		return true
+	}
+	return touches(a, p, q, turnA) || touches(b, p, q, turnB) || touches(p, a, b, turnP) || touches(q, a, b, turnQ)
+}
+
+// pointInRing reports whether a point lies inside a ring, closed from its last point back to its
+// first one, using the even-odd rule.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point.
+//   - ring ([][]float64): The points of the ring.
+//
+// Returns:
+//   - bool: True if the point lies inside the ring.
+func pointInRing(point []float64, ring [][]float64) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[1] > point[1]) != (b[1] > point[1]) &&
+			point[0] < (b[0]-a[0])*(point[1]-a[1])/(b[1]-a[1])+a[0] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// validatePlanarLines checks the input line collection of a decimation algorithm working on 2D
+// lines.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines to be validated.
+//
+// Returns:
+//   - error: An error wrapping ErrUnsupportedDimension if a point is not 2D, or another sentinel
+//     error if a line is not valid.
+//   - nil: If the input is valid.
+func (d Decimate) validatePlanarLines(lines [][][]float64) error {
+	if err := d.validateLines(lines); err != nil {
+		return err
+	}
+	for l, points := range lines {
+		if len(points[0]) != 2 {
+			return fmt.Errorf("%w: line %v has dimension %v, but only 2D lines are supported", ErrUnsupportedDimension, l, len(points[0]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+// TopologyPreserving simplifies a collection of 2D lines with the Douglas-Peucker algorithm while
+// keeping their topology. It panics if the input is not valid, see TopologyPreservingE for the
+// error-returning form.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][][]float64: The simplified lines, in the order of the input.
+func (d Decimate) TopologyPreserving(lines [][][]float64, threshold float64, opts ...Option) [][][]float64 {
+	result, err := d.TopologyPreservingE(lines, threshold, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// TopologyPreservingE simplifies a collection of 2D lines with the Douglas-Peucker algorithm
+// while keeping their topology.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][][]float64: The simplified lines, in the order of the input.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) TopologyPreservingE(lines [][][]float64, threshold float64, opts ...Option) ([][][]float64, error) {
+	indices, err := d.TopologyPreservingIndicesE(lines, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][][]float64, len(lines))
+	for l, points := range lines {
+		result[l] = SelectPoints(points, indices[l])
+	}
+	return result, nil
+}
+
+// TopologyPreservingIndices simplifies a collection of 2D lines with the Douglas-Peucker
+// algorithm while keeping their topology, and returns the positions of the kept points in every
+// line. It panics if the input is not valid, see TopologyPreservingIndicesE for the
+// error-returning form.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][]int: The positions of the kept points of every line, in increasing order.
+func (d Decimate) TopologyPreservingIndices(lines [][][]float64, threshold float64, opts ...Option) [][]int {
+	indices, err := d.TopologyPreservingIndicesE(lines, threshold, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return indices
+}
+
+// TopologyPreservingIndicesE simplifies a collection of 2D lines with the Douglas-Peucker
+// algorithm while keeping their topology, and returns the positions of the kept points in every
+// line.
+// This is the method of Saalfeld: every line is first simplified on its own, then every
+// simplified segment is checked against a spatial grid of the original vertices and of the other
+// simplified segments. A segment that crosses another one, or that makes an original vertex of
+// any line switch sides, is split at its farthest point as Douglas-Peucker would do with a lower
+// threshold. Checks are repeated until no segment changes the topology, so the simplified lines
+// cross each other only where the original lines do.
+//
+// Parameters:
+//   - lines ([][][]float64): The collection of lines to be simplified.
+//   - threshold (float64): The threshold to be used in the simplification.
+//   - opts (...Option): Options of the algorithm, such as WithDistance.
+//
+// Returns:
+//   - [][]int: The positions of the kept points of every line, in increasing order.
+//   - error: An error wrapping ErrUnsupportedDimension if the lines are not 2D,
+//     ErrInvalidParameter if WithPinned or WithCornerAngle is given, or another sentinel error if
+//     the input is not valid.
+func (d Decimate) TopologyPreservingIndicesE(lines [][][]float64, threshold float64, opts ...Option) ([][]int, error) {
+	if err := d.validatePlanarLines(lines); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("threshold", threshold); err != nil {
+		return nil, err
+	}
+
+	config := newOptions(opts)
+	if err := validateDistance(config.distance); err != nil {
+		return nil, err
+	}
+	if err := validateCollectionOptions(config); err != nil {
+		return nil, err
+	}
+	keep := make([][]bool, len(lines))
+	for l, points := range lines {
+		last := len(points) - 1
+		keep[l] = make([]bool, len(points))
+		keep[l][0] = true
+		keep[l][last] = true
+		d.douglasPeucker(points, 0, last, threshold, config, keep[l])
+	}
+
+	index := newTopology(lines, keep)
+	for changed := true; changed; {
+		changed = false
+		for l, points := range lines {
+			kept := keptIndices(keep[l])
+			for k := 1; k < len(kept); k++ {
+				first, last := kept[k-1], kept[k]
+				if last-first < 2 || !index.conflicts(l, first, last) {
+					continue
+				}
+
+				split, _ := d.farthestPoint(points, first, last, config)
+				if split == -1 {
+					split = (first + last) / 2
+				}
+				keep[l][split] = true
+				index.split(l, first, split, last)
+				changed = true
+			}
+		}
+	}
+
+	result := make([][]int, len(lines))
+	for l := range lines {
+		result[l] = keptIndices(keep[l])
+	}
+	return result, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"fmt"
+	"github.com/cenieto/decimate/pkg/interfaces"
+)
+
+// trajectoryStream holds the state shared by the online compressors of trajectories, which
+// receive the points of a trajectory one at a time.
+type trajectoryStream struct {
+	synchronized interfaces.SynchronizedGeometry // Geometry measuring synchronized distances
+	validate     func([][]float64) error         // Validation of the coordinates of a pushed point
+	count        int                             // Number of points pushed
+	last         []float64                       // Last pushed point, nil before the first push
+}
+
+// newTrajectoryStream builds the shared state of an online compressor of trajectories.
+//
+// Parameters:
+//   - d (Decimate): The decimate operation, whose geometry must have timestamps.
+//
+// Returns:
+//   - trajectoryStream: The state of a compressor that has not received any point.
+//   - error: An error wrapping ErrUnsupportedGeometry if the geometry does not implement
+//     interfaces.SynchronizedGeometry.
+func newTrajectoryStream(d Decimate) (trajectoryStream, error) {
+	synchronized, ok := d.Geometry.(interfaces.SynchronizedGeometry)
+	if !ok {
+		return trajectoryStream{}, fmt.Errorf("%w: trajectory streams need a geometry implementing interfaces.SynchronizedGeometry, such as trajectory.Euclid", ErrUnsupportedGeometry)
+	}
+	return trajectoryStream{synchronized: synchronized, validate: d.ValidateInputPointList}, nil
+}
+
+// accept validates the next point of the trajectory and records it as the last pushed point.
+//
+// Parameters:
+//   - point ([]float64): The point, whose timestamp is given by the geometry.
+//
+// Returns:
+//   - error: An error wrapping ErrNotMonotonic if the timestamp of the point is before the one
+//     of the previous point, or another sentinel error if the point is not valid.
+func (s *trajectoryStream) accept(point []float64) error {
+	if err := s.validate([][]float64{point}); err != nil {
+		return fmt.Errorf("point %v: %w", s.count, err)
+	}
+	if s.last != nil && s.synchronized.Time(point) < s.synchronized.Time(s.last) {
+		return fmt.Errorf("%w: timestamp decreases from %v to %v at point %v", ErrNotMonotonic, s.synchronized.Time(s.last), s.synchronized.Time(point), s.count)
+	}
+	s.last = point
+	s.count++
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package decimate
+
+import (
+	"container/heap"
+)
+
+// vertexArea is a vertex of the polyline being simplified by the Visvalingam-Whyatt algorithm.
+// Vertices are linked to their current neighbours so that removals can be done in constant time.
+type vertexArea struct {
+	index    int     // Position of the vertex in the input point list
+	area     float64 // Effective area of the triangle formed with its neighbours
+	previous int     // Position of the previous vertex still present, -1 if none
+	next     int     // Position of the next vertex still present, -1 if none
+	heapSlot int     // Position of the vertex inside the heap, -1 if not queued
+}
+
+// vertexAreaHeap is a min-heap of vertices ordered by effective area.
+// Ties are broken by the position of the vertex in the input so results are deterministic.
+type vertexAreaHeap []*vertexArea
+
+func (h vertexAreaHeap) Len() int { return len(h) }
+
+func (h vertexAreaHeap) Less(i, j int) bool {
+	if h[i].area == h[j].area {
+		return h[i].index < h[j].index
+	}
+	return h[i].area < h[j].area
+}
+
+func (h vertexAreaHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapSlot = i
+	h[j].heapSlot = j
+}
+
+func (h *vertexAreaHeap) Push(x any) {
+	vertex := x.(*vertexArea)
+	vertex.heapSlot = len(*h)
+	*h = append(*h, vertex)
+}
+
+func (h *vertexAreaHeap) Pop() any {
+	old := *h
+	size := len(old)
+	vertex := old[size-1]
+	old[size-1] = nil
+	vertex.heapSlot = -1
+	*h = old[:size-1]
+	return vertex
+}
+
+// effectiveArea computes the area of the triangle formed by the vertex at position index
+// and the vertices at positions previous and next.
+//
+// Parameters:
+//   - points ([][]float64): The list of points being simplified.
+//   - previous (int): Position of the previous vertex.
+//   - index (int): Position of the vertex whose area is computed.
+//   - next (int): Position of the next vertex.
+//
+// Returns:
+//   - float64: The area of the triangle.
+func (d Decimate) effectiveArea(points [][]float64, previous, index, next int) float64 {
+	return d.coordinates().DoubleAreaTriangleCoordinates(points[index], points[previous], points[next]) / 2.0
+}
+
+// VisvalingamWhyatt simplifies a list of points using the Visvalingam-Whyatt algorithm.
+// It panics if the input is not valid, see VisvalingamWhyattE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minArea (float64): The minimum effective area a vertex must have to be kept.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+func (d Decimate) VisvalingamWhyatt(points [][]float64, minArea float64, opts ...Option) [][]float64 {
+	return mustPoints(d.VisvalingamWhyattE(points, minArea, opts...))
+}
+
+// VisvalingamWhyattE simplifies a list of points using the Visvalingam-Whyatt algorithm.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minArea (float64): The minimum effective area a vertex must have to be kept.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - [][]float64: The simplified list of points.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) VisvalingamWhyattE(points [][]float64, minArea float64, opts ...Option) ([][]float64, error) {
+	indices, err := d.VisvalingamWhyattIndicesE(points, minArea, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SelectPoints(points, indices), nil
+}
+
+// VisvalingamWhyattIndices simplifies a list of points using the Visvalingam-Whyatt algorithm
+// and returns the positions of the kept points in the input list. It panics if the input is
+// not valid, see VisvalingamWhyattIndicesE for the error-returning form.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minArea (float64): The minimum effective area a vertex must have to be kept.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+func (d Decimate) VisvalingamWhyattIndices(points [][]float64, minArea float64, opts ...Option) []int {
+	return mustIndices(d.VisvalingamWhyattIndicesE(points, minArea, opts...))
+}
+
+// VisvalingamWhyattIndicesE simplifies a list of points using the Visvalingam-Whyatt algorithm
+// and returns the positions of the kept points in the input list.
+// The vertex with the smallest effective area, the area of the triangle formed with its two
+// neighbours, is removed iteratively until every remaining vertex has an effective area
+// greater than or equal to minArea. When a removal shrinks the area of a neighbour below the
+// area just removed, the neighbour inherits the removed area so vertices are always eliminated
+// in a consistent order.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minArea (float64): The minimum effective area a vertex must have to be kept.
+//   - opts (...Option): Options of the algorithm, such as WithPinned or WithCornerAngle.
+//
+// Returns:
+//   - []int: The positions of the kept points, in increasing order.
+//   - error: An error wrapping one of the sentinel errors if the input is not valid.
+func (d Decimate) VisvalingamWhyattIndicesE(points [][]float64, minArea float64, opts ...Option) ([]int, error) {
+
+	if err := d.validateInput(points); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold("minArea", minArea); err != nil {
+		return nil, err
+	}
+
+	config := newOptions(opts)
+	return d.splitAtPinned(points, config, func(section [][]float64) []int {
+		return d.visvalingamWhyatt(section, minArea)
+	})
+}
+
+// visvalingamWhyatt runs the Visvalingam-Whyatt algorithm over a section of the points between
+// pinned vertices.
+//
+// Parameters:
+//   - points ([][]float64): The list of points to be simplified.
+//   - minArea (float64): The minimum effective area a vertex must have to be kept.
+//
+// Returns:
+//   - []int: The positions of the kept points in the section, in increasing order.
+func (d Decimate) visvalingamWhyatt(points [][]float64, minArea float64) []int {
+	sizePoints := len(points)
+	if sizePoints == 2 {
+		return []int{0, 1}
+	}
+
+	vertices := make([]*vertexArea, sizePoints)
+	for i := range points {
+		vertices[i] = &vertexArea{index: i, previous: i - 1, next: i + 1, heapSlot: -1}
+	}
+	vertices[sizePoints-1].next = -1
+
+	queue := make(vertexAreaHeap, 0, sizePoints-2)
+	for i := 1; i < sizePoints-1; i++ {
+		vertices[i].area = d.effectiveArea(points, i-1, i, i+1)
+		heap.Push(&queue, vertices[i])
+	}
+
+	for queue.Len() > 0 {
+		if queue[0].area >= minArea {
+			break
+		}
+		removed := heap.Pop(&queue).(*vertexArea)
+		previous := vertices[removed.previous]
+		next := vertices[removed.next]
+		previous.next = next.index
+		next.previous = previous.index
+
+		for _, neighbour := range []*vertexArea{previous, next} {
+			if neighbour.heapSlot < 0 {
+				continue
+			}
+			area := d.effectiveArea(points, neighbour.previous, neighbour.index, neighbour.next)
+			if area < removed.area {
+				area = removed.area
+			}
+			neighbour.area = area
+			heap.Fix(&queue, neighbour.heapSlot)
+		}
+	}
+
+	var indices []int
+	for i := 0; i != -1; i = vertices[i].next {
+		indices = append(indices, i)
+	}
+	return indices
+}
@@ -0,0 +1,435 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package geodesic
+
+import (
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/primitives"
+	"math"
+)
+
+// Parameters of the WGS84 ellipsoid, used by GPS receivers and most geographic data.
+const (
+	WGS84SemiMajorAxis = 6378137.0         // Equatorial radius in meters
+	WGS84Flattening    = 1 / 298.257223563 // Flattening of the ellipsoid
+)
+
+const (
+	vincentyTolerance     = 1e-12 // Convergence tolerance of the Vincenty formulae, in radians
+	vincentyMaxIterations = 200   // Maximum number of iterations of the Vincenty formulae
+	interceptTolerance    = 1e-6  // Convergence tolerance of the cross-track intercept, in meters
+	interceptIterations   = 20    // Maximum number of iterations of the cross-track intercept
+)
+
+// Ellipsoid represents the surface of an ellipsoid of revolution, such as WGS84, where points are
+// given as longitude and latitude in degrees, in that order. Distances are measured along geodesics
+// with the Vincenty formulae and returned in the unit of the semi-major axis, so tolerances of the
+// decimation algorithms are expressed in meters with NewWGS84.
+type Ellipsoid struct {
+	SemiMajorAxis float64
+	Flattening    float64
+	Decimate      *decimate.Decimate
+}
+
+// NewEllipsoid creates and returns a new instance of Ellipsoid with the given parameters.
+//
+// Parameters:
+//   - semiMajorAxis (float64): The equatorial radius of the ellipsoid.
+//   - flattening (float64): The flattening of the ellipsoid, between 0 and 1.
+//
+// Returns:
+//   - *Ellipsoid: A new instance of the ellipsoidal geometry system.
+func NewEllipsoid(semiMajorAxis, flattening float64) *Ellipsoid {
+	e := &Ellipsoid{SemiMajorAxis: semiMajorAxis, Flattening: flattening}
+	e.Decimate = decimate.NewDecimate(*e)
+	return e
+}
+
+// NewWGS84 creates and returns a new instance of Ellipsoid with the parameters of WGS84.
+//
+// Returns:
+//   - *Ellipsoid: A new instance of the WGS84 geometry system.
+func NewWGS84() *Ellipsoid {
+	return NewEllipsoid(WGS84SemiMajorAxis, WGS84Flattening)
+}
+
+// Dimension returns the dimension of the geometry system.
+//
+// Returns:
+//   - int: The dimension of the geometry, which is always 2 for this system, longitude and latitude.
+func (g Ellipsoid) Dimension() int {
+	return 2
+}
+
+// CrossProduct computes the cross product of the unit vectors normal to the ellipsoid at the
+// positions given by two vectors of longitude and latitude.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The longitude and latitude of the first position.
+//   - v2 (*primitives.Vector): The longitude and latitude of the second position.
+//
+// Returns:
+//   - *primitives.Vector: A 3D vector normal to both unit vectors.
+func (g Ellipsoid) CrossProduct(v1, v2 *primitives.Vector) *primitives.Vector {
+	checkVectors("CrossProduct", "Ellipsoid", v1, v2)
+
+	result := cross(unitVector(v1.RawVector().Data), unitVector(v2.RawVector().Data))
+	return primitives.NewVector(result[:])
+}
+
+// CrossProductNorm computes the norm of the cross product of the unit vectors normal to the
+// ellipsoid at two positions.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The longitude and latitude of the first position.
+//   - v2 (*primitives.Vector): The longitude and latitude of the second position.
+//
+// Returns:
+//   - float64: The norm of the cross product.
+func (g Ellipsoid) CrossProductNorm(v1, v2 *primitives.Vector) float64 {
+	checkVectors("CrossProductNorm", "Ellipsoid", v1, v2)
+
+	result := cross(unitVector(v1.RawVector().Data), unitVector(v2.RawVector().Data))
+	return primitives.Norm(result[:])
+}
+
+// DoubleAreaTriangle calculates the double of the area of a triangle formed by a point and a line,
+// as the length of the line times the distance from the point to its geodesic. This is the
+// ellipsoidal area for triangles small compared to the ellipsoid, and keeps DistancePointLine
+// equal to the double area divided by the length of the line, as in Euclidean geometries.
+//
+// Parameters:
+//   - point (*primitives.Point): The point used to form the triangle.
+//   - line (*primitives.Line): The line forming the base of the triangle.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g Ellipsoid) DoubleAreaTriangle(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DoubleAreaTriangle", "Ellipsoid", point, line)
+	return g.DoubleAreaTriangleCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointLine computes the cross-track distance, the shortest distance from a point to the
+// geodesic going through the points of a line, extended beyond them. A line whose points coincide
+// defines no geodesic, so the distance to that single point is returned instead.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the line is being calculated.
+//   - line (*primitives.Line): The line to which the distance is being measured.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the geodesic.
+func (g Ellipsoid) DistancePointLine(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DistancePointLine", "Ellipsoid", point, line)
+	return g.DistancePointLineCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointSegment computes the shortest distance from a point to the geodesic between the
+// points of a line. Points whose projection falls outside the geodesic are measured to the nearest
+// end point.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the segment is being calculated.
+//   - line (*primitives.Line): The line whose points define the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the geodesic.
+func (g Ellipsoid) DistancePointSegment(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DistancePointSegment", "Ellipsoid", point, line)
+	return g.DistancePointSegmentCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// Distance computes the length of the geodesic between two points.
+//
+// Parameters:
+//   - start ([]float64): The longitude and latitude of the first point, in degrees.
+//   - end ([]float64): The longitude and latitude of the second point, in degrees.
+//
+// Returns:
+//   - float64: The distance between the points, in the unit of the semi-major axis.
+func (g Ellipsoid) Distance(start, end []float64) float64 {
+	distance, _, _ := g.inverse(start, end)
+	return distance
+}
+
+// DoubleAreaTriangleCoordinates calculates the double of the area of the triangle formed by a point
+// and the line going from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point used to form the triangle.
+//   - start ([]float64): The longitude and latitude of the first point of the line.
+//   - end ([]float64): The longitude and latitude of the second point of the line.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g Ellipsoid) DoubleAreaTriangleCoordinates(point, start, end []float64) float64 {
+	length, azimuth, _ := g.inverse(start, end)
+	if g.degenerate(length) {
+		return 0
+	}
+	crossTrack, _ := g.intercept(point, start, azimuth)
+	return length * crossTrack
+}
+
+// DistancePointLineCoordinates computes the cross-track distance from a point to the geodesic going
+// from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point whose distance is being calculated.
+//   - start ([]float64): The longitude and latitude of the first point of the line.
+//   - end ([]float64): The longitude and latitude of the second point of the line.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the geodesic.
+func (g Ellipsoid) DistancePointLineCoordinates(point, start, end []float64) float64 {
+	length, azimuth, _ := g.inverse(start, end)
+	if g.degenerate(length) {
+		return g.Distance(point, start)
+	}
+	crossTrack, _ := g.intercept(point, start, azimuth)
+	return crossTrack
+}
+
+// DistancePointSegmentCoordinates computes the shortest distance from a point to the geodesic going
+// from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point whose distance is being calculated.
+//   - start ([]float64): The longitude and latitude of the first point of the geodesic.
+//   - end ([]float64): The longitude and latitude of the second point of the geodesic.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the geodesic.
+func (g Ellipsoid) DistancePointSegmentCoordinates(point, start, end []float64) float64 {
+	length, azimuth, _ := g.inverse(start, end)
+	if g.degenerate(length) {
+		return g.Distance(point, start)
+	}
+
+	crossTrack, alongTrack := g.intercept(point, start, azimuth)
+	if alongTrack <= 0 {
+		return g.Distance(point, start)
+	}
+	if alongTrack >= length {
+		return g.Distance(point, end)
+	}
+	return crossTrack
+}
+
+// degenerate reports whether a geodesic is too short to have a direction.
+//
+// Parameters:
+//   - length (float64): The length of the geodesic.
+//
+// Returns:
+//   - bool: True if the end points of the geodesic are considered the same location.
+func (g Ellipsoid) degenerate(length float64) bool {
+	return length < degenerateSine*g.SemiMajorAxis
+}
+
+// meanRadius returns the mean radius of the ellipsoid, used to approximate it by a sphere.
+//
+// Returns:
+//   - float64: The mean of the three semi-axes of the ellipsoid.
+func (g Ellipsoid) meanRadius() float64 {
+	return g.SemiMajorAxis * (3 - g.Flattening) / 3
+}
+
+// intercept finds the point of the geodesic leaving start with the given azimuth that is closest to
+// a point, with the iterative method of Baselga and Martínez-Llario. At every step the along-track
+// distance is computed on a sphere tangent to the ellipsoid at the current intercept, and the
+// intercept moves along the geodesic by that distance, until the distance is negligible.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point.
+//   - start ([]float64): The longitude and latitude of the first point of the geodesic.
+//   - azimuth (float64): The azimuth of the geodesic at start, in radians.
+//
+// Returns:
+//   - float64: The distance from the point to the intercept, the cross-track distance.
+//   - float64: The signed distance from start to the intercept along the geodesic.
+func (g Ellipsoid) intercept(point, start []float64, azimuth float64) (float64, float64) {
+	radius := g.meanRadius()
+	current := [2]float64{start[0], start[1]}
+	alongTrack := 0.0
+
+	for i := 0; i < interceptIterations; i++ {
+		distance, azimuthPoint, _ := g.inverse(current[:], point)
+		angle := azimuthPoint - azimuth
+		step := radius * math.Atan2(math.Sin(distance/radius)*math.Cos(angle), math.Cos(distance/radius))
+		if math.Abs(step) < interceptTolerance {
+			return distance, alongTrack
+		}
+		current, azimuth = g.direct(current[:], azimuth, step)
+		alongTrack += step
+	}
+
+	return g.Distance(point, current[:]), alongTrack
+}
+
+// inverse solves the inverse geodesic problem with the Vincenty formulae: the length of the
+// geodesic between two points and its azimuths. Vincenty's iteration does not converge for nearly
+// opposite points, for which a sphere of the mean radius of the ellipsoid is used instead.
+//
+// Parameters:
+//   - start ([]float64): The longitude and latitude of the first point, in degrees.
+//   - end ([]float64): The longitude and latitude of the second point, in degrees.
+//
+// Returns:
+//   - float64: The length of the geodesic.
+//   - float64: The azimuth of the geodesic at start, in radians clockwise from north.
+//   - float64: The azimuth of the geodesic at end, in radians clockwise from north.
+func (g Ellipsoid) inverse(start, end []float64) (float64, float64, float64) {
+	a := g.SemiMajorAxis
+	f := g.Flattening
+	b := a * (1 - f)
+
+	latitude1 := start[1] * math.Pi / 180
+	latitude2 := end[1] * math.Pi / 180
+	longitude := math.Remainder((end[0]-start[0])*math.Pi/180, 2*math.Pi)
+
+	// Reduced latitudes, the latitudes on the auxiliary sphere.
+	u1 := math.Atan2((1-f)*math.Sin(latitude1), math.Cos(latitude1))
+	u2 := math.Atan2((1-f)*math.Sin(latitude2), math.Cos(latitude2))
+	sinU1, cosU1 := math.Sincos(u1)
+	sinU2, cosU2 := math.Sincos(u2)
+
+	lambda := longitude
+	var sinLambda, cosLambda, sinSigma, cosSigma, sigma, cosSquaredAlpha, cos2SigmaM float64
+	converged := false
+	for i := 0; i < vincentyMaxIterations; i++ {
+		sinLambda, cosLambda = math.Sincos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return 0, 0, 0
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSquaredAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cosSquaredAlpha != 0 {
+			// Geodesics along the equator have no vertex, cos2SigmaM is irrelevant for them.
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSquaredAlpha
+		}
+		c := f / 16 * cosSquaredAlpha * (4 + f*(4-3*cosSquaredAlpha))
+		previous := lambda
+		lambda = longitude + (1-c)*f*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-previous) < vincentyTolerance {
+			converged = true
+			break
+		}
+	}
+
+	if !converged || math.Abs(lambda) > math.Pi {
+		return g.sphericalInverse(start, end)
+	}
+
+	uSquared := cosSquaredAlpha * (a*a - b*b) / (b * b)
+	coefficientA := 1 + uSquared/16384*(4096+uSquared*(-768+uSquared*(320-175*uSquared)))
+	coefficientB := uSquared / 1024 * (256 + uSquared*(-128+uSquared*(74-47*uSquared)))
+	deltaSigma := coefficientB * sinSigma * (cos2SigmaM + coefficientB/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		coefficientB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+	distance := b * coefficientA * (sigma - deltaSigma)
+	azimuth1 := math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+	azimuth2 := math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda)
+	return distance, azimuth1, azimuth2
+}
+
+// sphericalInverse solves the inverse geodesic problem on a sphere of the mean radius of the
+// ellipsoid, as a fallback for the Vincenty formulae.
+//
+// Parameters:
+//   - start ([]float64): The longitude and latitude of the first point, in degrees.
+//   - end ([]float64): The longitude and latitude of the second point, in degrees.
+//
+// Returns:
+//   - float64: The length of the great circle arc.
+//   - float64: The azimuth of the arc at start, in radians clockwise from north.
+//   - float64: The azimuth of the arc at end, in radians clockwise from north.
+func (g Ellipsoid) sphericalInverse(start, end []float64) (float64, float64, float64) {
+	latitude1 := start[1] * math.Pi / 180
+	latitude2 := end[1] * math.Pi / 180
+	longitude := (end[0] - start[0]) * math.Pi / 180
+
+	azimuth1 := math.Atan2(math.Sin(longitude)*math.Cos(latitude2),
+		math.Cos(latitude1)*math.Sin(latitude2)-math.Sin(latitude1)*math.Cos(latitude2)*math.Cos(longitude))
+	azimuth2 := math.Atan2(math.Sin(longitude)*math.Cos(latitude1),
+		-math.Cos(latitude2)*math.Sin(latitude1)+math.Sin(latitude2)*math.Cos(latitude1)*math.Cos(longitude))
+	distance := g.meanRadius() * centralAngle(unitVector(start), unitVector(end))
+	return distance, azimuth1, azimuth2
+}
+
+// direct solves the direct geodesic problem with the Vincenty formulae: the point reached by
+// following the geodesic leaving start with the given azimuth for the given distance. Negative
+// distances follow the geodesic backwards.
+//
+// Parameters:
+//   - start ([]float64): The longitude and latitude of the first point, in degrees.
+//   - azimuth (float64): The azimuth of the geodesic at start, in radians clockwise from north.
+//   - distance (float64): The distance to travel along the geodesic.
+//
+// Returns:
+//   - [2]float64: The longitude and latitude of the point reached, in degrees.
+//   - float64: The azimuth of the geodesic at the point reached, in radians clockwise from north.
+func (g Ellipsoid) direct(start []float64, azimuth, distance float64) ([2]float64, float64) {
+	if distance < 0 {
+		end, backAzimuth := g.direct(start, azimuth+math.Pi, -distance)
+		return end, backAzimuth + math.Pi
+	}
+
+	a := g.SemiMajorAxis
+	f := g.Flattening
+	b := a * (1 - f)
+
+	latitude1 := start[1] * math.Pi / 180
+	sinAlpha1, cosAlpha1 := math.Sincos(azimuth)
+
+	u1 := math.Atan2((1-f)*math.Sin(latitude1), math.Cos(latitude1))
+	sinU1, cosU1 := math.Sincos(u1)
+	sigma1 := math.Atan2(math.Tan(u1), cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cosSquaredAlpha := 1 - sinAlpha*sinAlpha
+
+	uSquared := cosSquaredAlpha * (a*a - b*b) / (b * b)
+	coefficientA := 1 + uSquared/16384*(4096+uSquared*(-768+uSquared*(320-175*uSquared)))
+	coefficientB := uSquared / 1024 * (256 + uSquared*(-128+uSquared*(74-47*uSquared)))
+
+	sigma := distance / (b * coefficientA)
+	var sinSigma, cosSigma, cos2SigmaM float64
+	for i := 0; i < vincentyMaxIterations; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		deltaSigma := coefficientB * sinSigma * (cos2SigmaM + coefficientB/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+			coefficientB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+		previous := sigma
+		sigma = distance/(b*coefficientA) + deltaSigma
+		if math.Abs(sigma-previous) < vincentyTolerance {
+			break
+		}
+	}
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+	sinSigma, cosSigma = math.Sincos(sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	latitude2 := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-f)*math.Hypot(sinAlpha, x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	c := f / 16 * cosSquaredAlpha * (4 + f*(4-3*cosSquaredAlpha))
+	longitude := lambda - (1-c)*f*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+	longitude2 := math.Remainder(start[0]*math.Pi/180+longitude, 2*math.Pi)
+	azimuth2 := math.Atan2(sinAlpha, -x)
+	return [2]float64{longitude2 * 180 / math.Pi, latitude2 * 180 / math.Pi}, azimuth2
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package geodesic
+
+import (
+	"github.com/cenieto/decimate/pkg/primitives"
+	"math"
+	"testing"
+)
+
+// millimeter is the absolute tolerance used to compare distances on the ellipsoid.
+const millimeter = 1e-3
+
+// degrees converts an angle given in degrees, minutes and seconds to degrees.
+func degrees(d, m, s float64) float64 {
+	sign := 1.0
+	if d < 0 {
+		sign = -1.0
+	}
+	return sign * (math.Abs(d) + m/60 + s/3600)
+}
+
+// TestEllipsoidInstantiation tests the correct instantiation of an Ellipsoid object.
+func TestEllipsoidInstantiation(t *testing.T) {
+	wgs84 := NewWGS84()
+
+	if wgs84.Dimension() != 2 {
+		t.Errorf("wgs84.Dimension() = %v; want %v", wgs84.Dimension(), 2)
+	}
+	geometry := wgs84.Decimate.Geometry.(Ellipsoid)
+	if geometry.SemiMajorAxis != WGS84SemiMajorAxis || geometry.Flattening != WGS84Flattening {
+		t.Errorf("wgs84.Decimate.Geometry = %v, %v; want %v, %v", geometry.SemiMajorAxis, geometry.Flattening, WGS84SemiMajorAxis, WGS84Flattening)
+	}
+}
+
+// TestEllipsoidDistance tests the length of geodesics computed with the Vincenty inverse formula.
+// Verifies it against the example of Vincenty's paper, from Flinders Peak to Buninyong, and the
+// length of a degree along the equator.
+func TestEllipsoidDistance(t *testing.T) {
+	geom := NewWGS84()
+
+	tests := []struct {
+		name     string
+		start    []float64
+		end      []float64
+		expected float64
+	}{
+		{
+			"FlindersPeakBuninyong",
+			[]float64{degrees(144, 25, 29.52440), degrees(-37, 57, 3.72030)},
+			[]float64{degrees(143, 55, 35.38390), degrees(-37, 39, 10.15610)},
+			54972.271,
+		},
+		{"Equator", []float64{0, 0}, []float64{1, 0}, WGS84SemiMajorAxis * math.Pi / 180},
+		{"Antimeridian", []float64{179.5, 0}, []float64{-179.5, 0}, WGS84SemiMajorAxis * math.Pi / 180},
+		{"SamePoint", []float64{12, 34}, []float64{12, 34}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := geom.Distance(test.start, test.end)
+			if math.Abs(result-test.expected) > millimeter {
+				t.Errorf("Distance(%v, %v) = %v; want %v", test.start, test.end, result, test.expected)
+			}
+		})
+	}
+
+	// The azimuth of the example, 306°52'05.37", is only given to the hundredth of a second.
+	_, azimuth, _ := geom.inverse(tests[0].start, tests[0].end)
+	expected := degrees(306, 52, 5.37) - 360
+	if math.Abs(azimuth*180/math.Pi-expected) > 0.01/3600 {
+		t.Errorf("inverse() azimuth = %v; want %v", azimuth*180/math.Pi, expected)
+	}
+}
+
+// TestEllipsoidDirect tests the Vincenty direct formula.
+// Verifies that following a geodesic forwards and backwards returns to the start, and that the
+// distance to the reached point is the travelled distance.
+func TestEllipsoidDirect(t *testing.T) {
+	geom := NewWGS84()
+	start := []float64{-3.7, 40.4}
+
+	for _, distance := range []float64{10, 12345.6, 800000} {
+		end, azimuth := geom.direct(start, 1.0, distance)
+		if result := geom.Distance(start, end[:]); math.Abs(result-distance) > millimeter {
+			t.Errorf("Distance(%v, %v) = %v; want %v", start, end, result, distance)
+		}
+
+		back, _ := geom.direct(end[:], azimuth, -distance)
+		if result := geom.Distance(start, back[:]); result > millimeter {
+			t.Errorf("direct() went back to %v; want %v", back, start)
+		}
+	}
+}
+
+// TestEllipsoidDistancePointLine tests the cross-track distance and the distance to a geodesic.
+// The points are built by following the geodesic of the line and then a geodesic perpendicular to
+// it, so their distances to the line are known.
+func TestEllipsoidDistancePointLine(t *testing.T) {
+	geom := NewWGS84()
+
+	tests := []struct {
+		name       string
+		start      []float64
+		azimuth    float64
+		length     float64
+		alongTrack float64
+		crossTrack float64
+	}{
+		{"Inside", []float64{10, 50}, 40, 20000, 7000, 300},
+		{"InsideLeft", []float64{10, 50}, 40, 20000, 7000, -300},
+		{"Long", []float64{-70, -20}, 100, 300000, 120000, 50000},
+		{"Antimeridian", []float64{179.9, 60}, 80, 30000, 15000, 12},
+		{"NearPole", []float64{30, 89.9}, 10, 5000, 2500, 40},
+		{"BeforeStart", []float64{10, 50}, 40, 20000, -3000, 300},
+		{"AfterEnd", []float64{10, 50}, 40, 20000, 25000, 300},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			azimuth := test.azimuth * math.Pi / 180
+			end, _ := geom.direct(test.start, azimuth, test.length)
+			foot, footAzimuth := geom.direct(test.start, azimuth, test.alongTrack)
+			point, _ := geom.direct(foot[:], footAzimuth+math.Pi/2, test.crossTrack)
+
+			line := primitives.NewLine(primitives.NewPoint(test.start), primitives.NewPoint(end[:]))
+
+			result := geom.DistancePointLine(primitives.NewPoint(point[:]), line)
+			if math.Abs(result-math.Abs(test.crossTrack)) > millimeter {
+				t.Errorf("DistancePointLine(%v, %v) = %v; want %v", point, line.String(), result, math.Abs(test.crossTrack))
+			}
+
+			expected := math.Abs(test.crossTrack)
+			if test.alongTrack < 0 {
+				expected = geom.Distance(point[:], test.start)
+			} else if test.alongTrack > test.length {
+				expected = geom.Distance(point[:], end[:])
+			}
+			result = geom.DistancePointSegment(primitives.NewPoint(point[:]), line)
+			if math.Abs(result-expected) > millimeter {
+				t.Errorf("DistancePointSegment(%v, %v) = %v; want %v", point, line.String(), result, expected)
+			}
+
+			area := geom.DoubleAreaTriangle(primitives.NewPoint(point[:]), line)
+			if math.Abs(area-test.length*math.Abs(test.crossTrack)) > millimeter*test.length {
+				t.Errorf("DoubleAreaTriangle(%v, %v) = %v; want %v", point, line.String(), area, test.length*math.Abs(test.crossTrack))
+			}
+		})
+	}
+}
+
+// TestEllipsoidMatchesSphere tests the cross-track distance on the ellipsoid against the sphere.
+// Verifies that both agree to the flattening of the Earth.
+func TestEllipsoidMatchesSphere(t *testing.T) {
+	ellipsoid := NewWGS84()
+	sphere := NewSphere(MeanEarthRadius)
+
+	point := []float64{2.35, 48.86}
+	start := []float64{-0.13, 51.51}
+	end := []float64{4.35, 50.85}
+
+	expected := sphere.DistancePointLineCoordinates(point, start, end)
+	result := ellipsoid.DistancePointLineCoordinates(point, start, end)
+	if math.Abs(result-expected) > WGS84Flattening*expected {
+		t.Errorf("DistancePointLineCoordinates(%v, %v, %v) = %v; want %v", point, start, end, result, expected)
+	}
+}
+
+// TestEllipsoidDegenerate tests the distances to a line whose points coincide.
+// Verifies that the distance to that single point is returned and that the triangle has no area.
+func TestEllipsoidDegenerate(t *testing.T) {
+	geom := NewWGS84()
+	point := []float64{1, 0}
+	start := []float64{0, 0}
+	expected := WGS84SemiMajorAxis * math.Pi / 180
+
+	if result := geom.DistancePointLineCoordinates(point, start, start); math.Abs(result-expected) > millimeter {
+		t.Errorf("DistancePointLineCoordinates(%v, %v, %v) = %v; want %v", point, start, start, result, expected)
+	}
+	if result := geom.DistancePointSegmentCoordinates(point, start, start); math.Abs(result-expected) > millimeter {
+		t.Errorf("DistancePointSegmentCoordinates(%v, %v, %v) = %v; want %v", point, start, start, result, expected)
+	}
+	if result := geom.DoubleAreaTriangleCoordinates(point, start, start); result != 0 {
+		t.Errorf("DoubleAreaTriangleCoordinates(%v, %v, %v) = %v; want %v", point, start, start, result, 0)
+	}
+}
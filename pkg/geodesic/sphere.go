@@ -0,0 +1,340 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package geodesic
+
+import (
+	"fmt"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/primitives"
+	"math"
+)
+
+// MeanEarthRadius is the mean radius of the Earth in meters, as defined by the IUGG.
+const MeanEarthRadius = 6371008.8
+
+// degenerateSine is the sine of the central angle below which two points are considered the same
+// location, or opposite locations, for which there is no single great circle going through them.
+// It is about six micrometers on the Earth.
+const degenerateSine = 1e-12
+
+// Sphere represents the surface of a sphere, such as the Earth, where points are given as
+// longitude and latitude in degrees, in that order. Distances are measured along great circles
+// and returned in the unit of the radius, so tolerances of the decimation algorithms are
+// expressed in meters when using MeanEarthRadius.
+type Sphere struct {
+	Radius   float64
+	Decimate *decimate.Decimate
+}
+
+// NewSphere creates and returns a new instance of Sphere with the given radius.
+//
+// Parameters:
+//   - radius (float64): The radius of the sphere, such as MeanEarthRadius.
+//
+// Returns:
+//   - *Sphere: A new instance of the spherical geometry system.
+func NewSphere(radius float64) *Sphere {
+	s := &Sphere{Radius: radius}
+	s.Decimate = decimate.NewDecimate(*s)
+	return s
+}
+
+// Dimension returns the dimension of the geometry system.
+//
+// Returns:
+//   - int: The dimension of the geometry, which is always 2 for this system, longitude and latitude.
+func (g Sphere) Dimension() int {
+	return 2
+}
+
+// CrossProduct computes the cross product of the unit vectors pointing from the center of the
+// sphere to the positions given by two vectors of longitude and latitude. The result is normal to
+// the plane of the great circle going through both positions.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The longitude and latitude of the first position.
+//   - v2 (*primitives.Vector): The longitude and latitude of the second position.
+//
+// Returns:
+//   - *primitives.Vector: A 3D vector normal to the great circle through the positions.
+func (g Sphere) CrossProduct(v1, v2 *primitives.Vector) *primitives.Vector {
+	checkVectors("CrossProduct", "Sphere", v1, v2)
+
+	result := cross(unitVector(v1.RawVector().Data), unitVector(v2.RawVector().Data))
+	return primitives.NewVector(result[:])
+}
+
+// CrossProductNorm computes the norm of the cross product of the unit vectors of two positions,
+// the sine of the central angle between them.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The longitude and latitude of the first position.
+//   - v2 (*primitives.Vector): The longitude and latitude of the second position.
+//
+// Returns:
+//   - float64: The sine of the central angle between the positions.
+func (g Sphere) CrossProductNorm(v1, v2 *primitives.Vector) float64 {
+	checkVectors("CrossProductNorm", "Sphere", v1, v2)
+
+	result := cross(unitVector(v1.RawVector().Data), unitVector(v2.RawVector().Data))
+	return primitives.Norm(result[:])
+}
+
+// DoubleAreaTriangle calculates the double of the area of a triangle formed by a point and a line,
+// as the length of the line times the distance from the point to its great circle. This is the
+// spherical area for triangles small compared to the sphere, and keeps DistancePointLine equal to
+// the double area divided by the length of the line, as in Euclidean geometries.
+//
+// Parameters:
+//   - point (*primitives.Point): The point used to form the triangle.
+//   - line (*primitives.Line): The line forming the base of the triangle.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g Sphere) DoubleAreaTriangle(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DoubleAreaTriangle", "Sphere", point, line)
+	return g.DoubleAreaTriangleCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointLine computes the cross-track distance, the shortest distance from a point to the
+// great circle going through the points of a line. A line whose points coincide defines no great
+// circle, so the distance to that single point is returned instead. The same applies to lines
+// joining opposite points, which lie on infinitely many great circles.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the line is being calculated.
+//   - line (*primitives.Line): The line to which the distance is being measured.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the great circle.
+func (g Sphere) DistancePointLine(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DistancePointLine", "Sphere", point, line)
+	return g.DistancePointLineCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointSegment computes the shortest distance from a point to the shortest great circle arc
+// between the points of a line. Points whose projection falls outside the arc are measured to the
+// nearest end point.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the segment is being calculated.
+//   - line (*primitives.Line): The line whose points define the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the arc.
+func (g Sphere) DistancePointSegment(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DistancePointSegment", "Sphere", point, line)
+	return g.DistancePointSegmentCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// Distance computes the great circle distance between two points.
+//
+// Parameters:
+//   - start ([]float64): The longitude and latitude of the first point, in degrees.
+//   - end ([]float64): The longitude and latitude of the second point, in degrees.
+//
+// Returns:
+//   - float64: The distance between the points, in the unit of the radius.
+func (g Sphere) Distance(start, end []float64) float64 {
+	return g.Radius * centralAngle(unitVector(start), unitVector(end))
+}
+
+// DoubleAreaTriangleCoordinates calculates the double of the area of the triangle formed by a point
+// and the line going from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point used to form the triangle.
+//   - start ([]float64): The longitude and latitude of the first point of the line.
+//   - end ([]float64): The longitude and latitude of the second point of the line.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g Sphere) DoubleAreaTriangleCoordinates(point, start, end []float64) float64 {
+	a := unitVector(start)
+	b := unitVector(end)
+	normal, length, ok := greatCircle(a, b)
+	if !ok {
+		return 0
+	}
+	return g.Radius * length * g.Radius * crossTrackAngle(unitVector(point), normal)
+}
+
+// DistancePointLineCoordinates computes the cross-track distance from a point to the great circle
+// going from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point whose distance is being calculated.
+//   - start ([]float64): The longitude and latitude of the first point of the line.
+//   - end ([]float64): The longitude and latitude of the second point of the line.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the great circle.
+func (g Sphere) DistancePointLineCoordinates(point, start, end []float64) float64 {
+	p := unitVector(point)
+	a := unitVector(start)
+	normal, _, ok := greatCircle(a, unitVector(end))
+	if !ok {
+		return g.Radius * centralAngle(p, a)
+	}
+	return g.Radius * crossTrackAngle(p, normal)
+}
+
+// DistancePointSegmentCoordinates computes the shortest distance from a point to the shortest great
+// circle arc going from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point whose distance is being calculated.
+//   - start ([]float64): The longitude and latitude of the first point of the arc.
+//   - end ([]float64): The longitude and latitude of the second point of the arc.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the arc.
+func (g Sphere) DistancePointSegmentCoordinates(point, start, end []float64) float64 {
+	p := unitVector(point)
+	a := unitVector(start)
+	b := unitVector(end)
+	normal, _, ok := greatCircle(a, b)
+	if !ok {
+		return g.Radius * centralAngle(p, a)
+	}
+
+	// The projection of the point on the great circle lies on the arc when it is after start and
+	// before end, turning around the normal of the great circle.
+	if dot(cross(a, p), normal) >= 0 && dot(cross(p, b), normal) >= 0 {
+		return g.Radius * crossTrackAngle(p, normal)
+	}
+	return g.Radius * math.Min(centralAngle(p, a), centralAngle(p, b))
+}
+
+// checkVectors panics if any of the given vectors is not made of a longitude and a latitude.
+//
+// Parameters:
+//   - operation (string): The name of the operation, used in the panic message.
+//   - geometry (string): The name of the geometry, used in the panic message.
+//   - v1 (*primitives.Vector): The first vector of the operation.
+//   - v2 (*primitives.Vector): The second vector of the operation.
+func checkVectors(operation, geometry string, v1, v2 *primitives.Vector) {
+	errorMsg := ""
+	if v1.Dimension() != 2 {
+		errorMsg += fmt.Sprintf("First vector is not 2D, is of dimension %d\n", v1.Dimension())
+	}
+	if v2.Dimension() != 2 {
+		errorMsg += fmt.Sprintf("Second vector is not 2D, is of dimension %d\n", v2.Dimension())
+	}
+	if errorMsg != "" {
+		errorMsg = fmt.Sprintf("%s in %s only accepts longitude and latitude vectors.\n %s", operation, geometry, errorMsg)
+		panic(errorMsg)
+	}
+}
+
+// checkPointLine panics if the point or any point of the line is not made of a longitude and a latitude.
+//
+// Parameters:
+//   - operation (string): The name of the operation, used in the panic message.
+//   - geometry (string): The name of the geometry, used in the panic message.
+//   - point (*primitives.Point): The point of the operation.
+//   - line (*primitives.Line): The line of the operation.
+func checkPointLine(operation, geometry string, point *primitives.Point, line *primitives.Line) {
+	errorMsg := ""
+	if point.Dimension() != 2 {
+		errorMsg += fmt.Sprintf("Point is not 2D, is of dimension %d\n", point.Dimension())
+	}
+	if line.Point1.Dimension() != 2 || line.Point2.Dimension() != 2 {
+		errorMsg += fmt.Sprintf("Line is not 2D, has points of dimension %d and %d\n", line.Point1.Dimension(), line.Point2.Dimension())
+	}
+	if errorMsg != "" {
+		errorMsg = fmt.Sprintf("%s in %s only accepts longitude and latitude points.\n %s", operation, geometry, errorMsg)
+		panic(errorMsg)
+	}
+}
+
+// unitVector returns the unit vector pointing from the center of the sphere to a position.
+//
+// Parameters:
+//   - position ([]float64): The longitude and latitude of the position, in degrees.
+//
+// Returns:
+//   - [3]float64: The unit vector of the position.
+func unitVector(position []float64) [3]float64 {
+	longitude := position[0] * math.Pi / 180
+	latitude := position[1] * math.Pi / 180
+	return [3]float64{
+		math.Cos(latitude) * math.Cos(longitude),
+		math.Cos(latitude) * math.Sin(longitude),
+		math.Sin(latitude),
+	}
+}
+
+// cross computes the cross product of two 3D vectors.
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		-a[0]*b[2] + a[2]*b[0],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+// dot computes the dot product of two 3D vectors.
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+// centralAngle computes the angle between two unit vectors, in radians. It uses the arctangent of
+// the sine and cosine of the angle, which is accurate for both small and large angles.
+//
+// Parameters:
+//   - a ([3]float64): The first unit vector.
+//   - b ([3]float64): The second unit vector.
+//
+// Returns:
+//   - float64: The angle between the vectors.
+func centralAngle(a, b [3]float64) float64 {
+	c := cross(a, b)
+	return math.Atan2(primitives.Norm(c[:]), dot(a, b))
+}
+
+// greatCircle computes the unit normal of the great circle going through two unit vectors and the
+// central angle between them.
+//
+// Parameters:
+//   - a ([3]float64): The first unit vector.
+//   - b ([3]float64): The second unit vector.
+//
+// Returns:
+//   - [3]float64: The unit normal of the great circle.
+//   - float64: The central angle between the vectors.
+//   - bool: False if the vectors are equal or opposite and no single great circle goes through them.
+func greatCircle(a, b [3]float64) ([3]float64, float64, bool) {
+	c := cross(a, b)
+	norm := primitives.Norm(c[:])
+	angle := math.Atan2(norm, dot(a, b))
+	if norm < degenerateSine {
+		return [3]float64{}, angle, false
+	}
+	return [3]float64{c[0] / norm, c[1] / norm, c[2] / norm}, angle, true
+}
+
+// crossTrackAngle computes the angle between a unit vector and the great circle of the given unit
+// normal, in radians.
+//
+// Parameters:
+//   - p ([3]float64): The unit vector.
+//   - normal ([3]float64): The unit normal of the great circle.
+//
+// Returns:
+//   - float64: The angle between the vector and the great circle.
+func crossTrackAngle(p, normal [3]float64) float64 {
+	c := cross(p, normal)
+	return math.Atan2(math.Abs(dot(p, normal)), primitives.Norm(c[:]))
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package geodesic
+
+import (
+	"github.com/cenieto/decimate/pkg/primitives"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"math"
+	"testing"
+)
+
+// geodesicTolerance is the relative tolerance used to compare distances with the reference values,
+// computed with the haversine and cross-track formulas.
+const geodesicTolerance = 1e-8
+
+// geodesicToleranceMeters is the absolute tolerance used to compare short distances with the
+// reference values. Distances of a few meters computed from coordinates in degrees are only
+// accurate to some micrometers in double precision, whatever the formula.
+const geodesicToleranceMeters = 1e-5
+
+// TestSphereInstantiation tests the correct instantiation of a Sphere object.
+func TestSphereInstantiation(t *testing.T) {
+	sphere := NewSphere(MeanEarthRadius)
+
+	if sphere.Dimension() != 2 {
+		t.Errorf("sphere.Dimension() = %v; want %v", sphere.Dimension(), 2)
+	}
+	if sphere.Decimate.Geometry.(Sphere).Radius != MeanEarthRadius {
+		t.Errorf("sphere.Decimate.Geometry.Radius = %v; want %v", sphere.Decimate.Geometry.(Sphere).Radius, MeanEarthRadius)
+	}
+}
+
+// TestSphereDistancePointLine tests the calculation of the double area of a triangle, the cross-track
+// distance and the distance to an arc on the sphere, near the equator, the antimeridian and the poles.
+// Verifies that the primitive and the coordinates forms return the same values.
+// Set of inputs and expected results are read from a CSV file.
+func TestSphereDistancePointLine(t *testing.T) {
+	fixtureFile := "../../testdata/geodesic/sphere-point-line.csv"
+	reader, err := testutils.NewCSVFloat64Reader(fixtureFile)
+	if err != nil {
+		t.Fatalf("Error while opening CSV file: %v", err)
+	}
+
+	geom := NewSphere(MeanEarthRadius)
+	for values := range reader.ReadLines() {
+		if values.Err != nil {
+			t.Fatalf("Error while reading CSV file: %v", values.Err)
+		}
+
+		point := values.Values[0:2]
+		start := values.Values[2:4]
+		end := values.Values[4:6]
+		line := primitives.NewLine(primitives.NewPoint(start), primitives.NewPoint(end))
+
+		// The double area is a distance times the length of the line, and so is its tolerance.
+		length := geom.Distance(start, end)
+
+		results := []struct {
+			name      string
+			primitive float64
+			coords    float64
+			expected  float64
+			tolerance float64
+		}{
+			{"DoubleAreaTriangle", geom.DoubleAreaTriangle(primitives.NewPoint(point), line), geom.DoubleAreaTriangleCoordinates(point, start, end), values.Values[6], geodesicToleranceMeters * length},
+			{"DistancePointLine", geom.DistancePointLine(primitives.NewPoint(point), line), geom.DistancePointLineCoordinates(point, start, end), values.Values[7], geodesicToleranceMeters},
+			{"DistancePointSegment", geom.DistancePointSegment(primitives.NewPoint(point), line), geom.DistancePointSegmentCoordinates(point, start, end), values.Values[8], geodesicToleranceMeters},
+		}
+
+		for _, result := range results {
+			if math.Abs(result.primitive-result.expected) > geodesicTolerance*result.expected+result.tolerance {
+				t.Errorf("%v(%v, %v) = %v; want %v", result.name, point, line.String(), result.primitive, result.expected)
+			}
+			if result.primitive != result.coords {
+				t.Errorf("%vCoordinates(%v, %v, %v) = %v; want %v", result.name, point, start, end, result.coords, result.primitive)
+			}
+		}
+	}
+}
+
+// TestSphereAntimeridian tests the cross-track distance to a line crossing the antimeridian.
+// Verifies that it is the same as for the equivalent line crossing the prime meridian.
+func TestSphereAntimeridian(t *testing.T) {
+	geom := NewSphere(MeanEarthRadius)
+
+	// One degree of latitude on the sphere.
+	expected := MeanEarthRadius * math.Pi / 180
+
+	tests := []struct {
+		point []float64
+		start []float64
+		end   []float64
+	}{
+		{[]float64{0, 1}, []float64{-1, 0}, []float64{1, 0}},
+		{[]float64{180, 1}, []float64{179, 0}, []float64{-179, 0}},
+		{[]float64{-180, 1}, []float64{179, 0}, []float64{-179, 0}},
+	}
+
+	for _, test := range tests {
+		result := geom.DistancePointSegmentCoordinates(test.point, test.start, test.end)
+		if math.Abs(result-expected) > geodesicTolerance*expected {
+			t.Errorf("DistancePointSegmentCoordinates(%v, %v, %v) = %v; want %v", test.point, test.start, test.end, result, expected)
+		}
+	}
+}
+
+// TestSphereDegenerate tests the distances to a line whose points coincide or are opposite.
+// Verifies that the distance to the first point of the line is returned and that the triangle has no area.
+func TestSphereDegenerate(t *testing.T) {
+	geom := NewSphere(MeanEarthRadius)
+	point := []float64{0, 90}
+	expected := MeanEarthRadius * math.Pi / 2
+
+	for _, end := range [][]float64{{10, 0}, {-170, 0}} {
+		start := []float64{10, 0}
+		if result := geom.DistancePointLineCoordinates(point, start, end); math.Abs(result-expected) > geodesicTolerance*expected {
+			t.Errorf("DistancePointLineCoordinates(%v, %v, %v) = %v; want %v", point, start, end, result, expected)
+		}
+		if result := geom.DistancePointSegmentCoordinates(point, start, end); math.Abs(result-expected) > geodesicTolerance*expected {
+			t.Errorf("DistancePointSegmentCoordinates(%v, %v, %v) = %v; want %v", point, start, end, result, expected)
+		}
+		if result := geom.DoubleAreaTriangleCoordinates(point, start, end); result != 0 {
+			t.Errorf("DoubleAreaTriangleCoordinates(%v, %v, %v) = %v; want %v", point, start, end, result, 0)
+		}
+	}
+}
+
+// TestSphereCrossProduct tests the cross product of the unit vectors of two positions.
+// Verifies that its norm is the sine of the central angle between them.
+func TestSphereCrossProduct(t *testing.T) {
+	geom := NewSphere(MeanEarthRadius)
+	v1 := primitives.NewVector([]float64{0, 0})
+	v2 := primitives.NewVector([]float64{30, 0})
+
+	result := geom.CrossProduct(v1, v2)
+	if math.Abs(result.At(2, 0)-0.5) > testutils.TestToleranceAbsolute || result.Dimension() != 3 {
+		t.Errorf("CrossProduct(%v, %v) = %v; want (0, 0, 0.5)", v1, v2, result)
+	}
+	if norm := geom.CrossProductNorm(v1, v2); math.Abs(norm-0.5) > testutils.TestToleranceAbsolute {
+		t.Errorf("CrossProductNorm(%v, %v) = %v; want %v", v1, v2, norm, 0.5)
+	}
+}
+
+// TestSphereInvalid tests the methods of Sphere with invalid inputs.
+// Verifies that the methods panic when the points are not made of a longitude and a latitude.
+func TestSphereInvalid(t *testing.T) {
+	geom := NewSphere(MeanEarthRadius)
+	point := primitives.NewPoint([]float64{1, 2, 3})
+	line := primitives.NewLine(primitives.NewPoint([]float64{1, 2}), primitives.NewPoint([]float64{3, 4}))
+	vector := primitives.NewVector([]float64{1, 2, 3})
+
+	tests := map[string]func(){
+		"CrossProduct":         func() { geom.CrossProduct(vector, vector) },
+		"CrossProductNorm":     func() { geom.CrossProductNorm(vector, vector) },
+		"DoubleAreaTriangle":   func() { geom.DoubleAreaTriangle(point, line) },
+		"DistancePointLine":    func() { geom.DistancePointLine(point, line) },
+		"DistancePointSegment": func() { geom.DistancePointSegment(point, line) },
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%v did not panic", name)
+				}
+			}()
+			call()
+		})
+	}
+}
@@ -121,7 +121,8 @@ func (g Euclid3D) DoubleAreaTriangle(point *primitives.Point, line *primitives.L
 
 // DistancePointLine computes the shortest distance from a point to a line.
 // It divides the double area of the triangle formed by the point and the line
-// by the norm of the line's direction vector.
+// by the norm of the line's direction vector. A line whose points coincide has
+// no direction, so the distance to that single point is returned instead.
 //
 // Parameters:
 //   - point (*Point3D): The point whose distance to the line is being calculated.
@@ -131,7 +132,109 @@ func (g Euclid3D) DoubleAreaTriangle(point *primitives.Point, line *primitives.L
 //   - float64: The shortest distance from the point to the line.
 func (g Euclid3D) DistancePointLine(point *primitives.Point, line *primitives.Line) float64 {
 
-	numerator := g.DoubleAreaTriangle(point, line)
 	denominator := line.VectorDirector().Length()
+	if denominator == 0 {
+		return primitives.NewVectorTwoPoints(line.Point1, point).Length()
+	}
+	numerator := g.DoubleAreaTriangle(point, line)
 	return numerator / denominator
 }
+
+// DoubleAreaTriangleCoordinates calculates the double of the area of the triangle formed by a point
+// and the line going from start to end. It performs the same operations as DoubleAreaTriangle
+// directly on the coordinates, without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point used to form the triangle.
+//   - start ([]float64): The coordinates of the first point of the line.
+//   - end ([]float64): The coordinates of the second point of the line.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g Euclid3D) DoubleAreaTriangleCoordinates(point, start, end []float64) float64 {
+	v1 := [3]float64{start[0] - point[0], start[1] - point[1], start[2] - point[2]}
+	v2 := [3]float64{end[0] - start[0], end[1] - start[1], end[2] - start[2]}
+	cross := [3]float64{
+		v1[1]*v2[2] - v1[2]*v2[1],
+		-v1[0]*v2[2] + v1[2]*v2[0],
+		v1[0]*v2[1] - v1[1]*v2[0],
+	}
+	return primitives.Norm(cross[:])
+}
+
+// DistancePointLineCoordinates computes the shortest distance from a point to the line going from
+// start to end. It performs the same operations as DistancePointLine directly on the coordinates,
+// without allocating primitives, including the fallback for lines whose points coincide.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point whose distance to the line is being calculated.
+//   - start ([]float64): The coordinates of the first point of the line.
+//   - end ([]float64): The coordinates of the second point of the line.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the line.
+func (g Euclid3D) DistancePointLineCoordinates(point, start, end []float64) float64 {
+	director := [3]float64{end[0] - start[0], end[1] - start[1], end[2] - start[2]}
+	denominator := primitives.Norm(director[:])
+	if denominator == 0 {
+		startToPoint := [3]float64{point[0] - start[0], point[1] - start[1], point[2] - start[2]}
+		return primitives.Norm(startToPoint[:])
+	}
+	return g.DoubleAreaTriangleCoordinates(point, start, end) / denominator
+}
+
+// DistancePointSegment computes the shortest distance from a point to the segment between the
+// points of a line. The projection of the point is clamped to the segment, so points beyond its
+// ends are measured to the nearest end point. A segment of zero length is treated as a point.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the segment is being calculated.
+//   - line (*primitives.Line): The line whose points define the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the segment.
+func (g Euclid3D) DistancePointSegment(point *primitives.Point, line *primitives.Line) float64 {
+	errorMsg := ""
+	if point.Dimension() != g.Dimension() {
+		errorMsg += fmt.Sprintf("Point is not 3D, is of dimension %d\n", point.Dimension())
+	}
+	if line.Point1.Dimension() != g.Dimension() || line.Point2.Dimension() != g.Dimension() {
+		errorMsg += fmt.Sprintf("Line is not 3D, has points of dimension %d and %d\n", line.Point1.Dimension(), line.Point2.Dimension())
+	}
+	if errorMsg != "" {
+		errorMsg = fmt.Sprintf("DistancePointSegment in Euclid3D only accepts 3D points.\n %s", errorMsg)
+		panic(errorMsg)
+	}
+
+	return g.DistancePointSegmentCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointSegmentCoordinates computes the shortest distance from a point to the segment going
+// from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point whose distance to the segment is being calculated.
+//   - start ([]float64): The coordinates of the first point of the segment.
+//   - end ([]float64): The coordinates of the second point of the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the segment.
+func (g Euclid3D) DistancePointSegmentCoordinates(point, start, end []float64) float64 {
+	var startToPoint, endToPoint [3]float64
+	projection := 0.0
+	lengthSquared := 0.0
+	for i := range startToPoint {
+		startToPoint[i] = point[i] - start[i]
+		endToPoint[i] = point[i] - end[i]
+		projection += startToPoint[i] * (end[i] - start[i])
+		lengthSquared += (end[i] - start[i]) * (end[i] - start[i])
+	}
+
+	if lengthSquared == 0 || projection <= 0 {
+		return primitives.Norm(startToPoint[:])
+	}
+	if projection >= lengthSquared {
+		return primitives.Norm(endToPoint[:])
+	}
+	return g.DistancePointLineCoordinates(point, start, end)
+}
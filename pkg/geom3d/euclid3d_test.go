@@ -335,3 +335,141 @@ func TestDistancePointLineInvalid(t *testing.T) {
 
 	geom.DistancePointLine(point, line)
 }
+
+// TestDistancePointLineDegenerate tests the DistancePointLine method with a line whose points coincide.
+// Verifies that the distance to that single point is returned, by both the primitive and the coordinates forms.
+func TestDistancePointLineDegenerate(t *testing.T) {
+	geom := NewEuclid()
+
+	tests := []struct {
+		point    []float64
+		start    []float64
+		expected float64
+	}{
+		{[]float64{2, 3, 6}, []float64{0, 0, 0}, 7},
+		{[]float64{1, 1, 1}, []float64{1, 1, 1}, 0},
+		{[]float64{-2, 1, 0}, []float64{1, 5, 12}, 13},
+	}
+
+	for _, test := range tests {
+		line := primitives.NewLine(primitives.NewPoint(test.start), primitives.NewPoint(test.start))
+
+		result := geom.DistancePointLine(primitives.NewPoint(test.point), line)
+		if math.Abs(result-test.expected) > testutils.TestToleranceAbsolute {
+			t.Errorf("DistancePointLine(%v, %v) = %v; want %v", test.point, line.String(), result, test.expected)
+		}
+
+		result = geom.DistancePointLineCoordinates(test.point, test.start, test.start)
+		if math.Abs(result-test.expected) > testutils.TestToleranceAbsolute {
+			t.Errorf("DistancePointLineCoordinates(%v, %v, %v) = %v; want %v", test.point, test.start, test.start, result, test.expected)
+		}
+	}
+}
+
+// TestCoordinatesMatchPrimitives tests the DoubleAreaTriangleCoordinates and DistancePointLineCoordinates methods.
+// Verifies that they return the expected values and exactly the same results as DoubleAreaTriangle and DistancePointLine.
+// Set of inputs and expected results are read from a CSV file.
+func TestCoordinatesMatchPrimitives(t *testing.T) {
+	fixtureFile := "../../testdata/geom3d/point-line.csv"
+	reader, err := testutils.NewCSVFloat64Reader(fixtureFile)
+	if err != nil {
+		t.Fatalf("Error while opening CSV file: %v", err)
+	}
+
+	lines := reader.ReadLines()
+
+	for values := range lines {
+		if values.Err != nil {
+			t.Fatalf("Error while reading CSV file: %v", values.Err)
+		}
+
+		geom := NewEuclid()
+		point := []float64{values.Values[0], values.Values[1], values.Values[2]}
+		start := []float64{values.Values[3], values.Values[4], values.Values[5]}
+		end := []float64{values.Values[6], values.Values[7], values.Values[8]}
+		line := primitives.NewLine(primitives.NewPoint(start), primitives.NewPoint(end))
+
+		area := geom.DoubleAreaTriangleCoordinates(point, start, end)
+		if area != geom.DoubleAreaTriangle(primitives.NewPoint(point), line) {
+			t.Errorf("DoubleAreaTriangleCoordinates(%v, %v, %v) = %v; want %v", point, start, end, area, geom.DoubleAreaTriangle(primitives.NewPoint(point), line))
+		}
+		if !mat.EqualApprox(mat.NewVecDense(1, []float64{area}), mat.NewVecDense(1, []float64{values.Values[9]}), testutils.TestToleranceRelative) {
+			t.Errorf("DoubleAreaTriangleCoordinates(%v, %v, %v) = %v; want %v", point, start, end, area, values.Values[9])
+		}
+
+		distance := geom.DistancePointLineCoordinates(point, start, end)
+		if distance != geom.DistancePointLine(primitives.NewPoint(point), line) {
+			t.Errorf("DistancePointLineCoordinates(%v, %v, %v) = %v; want %v", point, start, end, distance, geom.DistancePointLine(primitives.NewPoint(point), line))
+		}
+		if !mat.EqualApprox(mat.NewVecDense(1, []float64{distance}), mat.NewVecDense(1, []float64{values.Values[10]}), testutils.TestToleranceRelative) {
+			t.Errorf("DistancePointLineCoordinates(%v, %v, %v) = %v; want %v", point, start, end, distance, values.Values[10])
+		}
+	}
+}
+
+// TestDistancePointSegment tests the calculation of the distance from a point to a segment.
+// Verifies that the projection of the point is clamped to the segment, including segments of zero length.
+// Set of inputs and expected results are read from a CSV file.
+func TestDistancePointSegment(t *testing.T) {
+	fixtureFile := "../../testdata/geom3d/point-segment.csv"
+	reader, err := testutils.NewCSVFloat64Reader(fixtureFile)
+	if err != nil {
+		t.Fatalf("Error while opening CSV file: %v", err)
+	}
+
+	lines := reader.ReadLines()
+
+	for values := range lines {
+		if values.Err != nil {
+			t.Fatalf("Error while reading CSV file: %v", values.Err)
+		}
+
+		geom := NewEuclid()
+		point := primitives.NewPoint(
+			[]float64{
+				values.Values[0],
+				values.Values[1],
+				values.Values[2],
+			},
+		)
+		point_origin_line := primitives.NewPoint(
+			[]float64{
+				values.Values[3],
+				values.Values[4],
+				values.Values[5],
+			},
+		)
+		point_end_line := primitives.NewPoint(
+			[]float64{
+				values.Values[6],
+				values.Values[7],
+				values.Values[8],
+			},
+		)
+		line := primitives.NewLine(point_origin_line, point_end_line)
+
+		result := geom.DistancePointSegment(point, line)
+		expected := values.Values[9]
+
+		if math.Abs(result-expected) > testutils.TestToleranceAbsolute {
+			t.Errorf("DistancePointSegment(%v, %v) = %v; want %v", point.String(), line.String(), result, expected)
+		}
+	}
+}
+
+// TestDistancePointSegmentInvalid tests the DistancePointSegment method with invalid inputs.
+// Verifies that the method panics when given invalid inputs.
+func TestDistancePointSegmentInvalid(t *testing.T) {
+	geom := NewEuclid()
+
+	point := primitives.NewPoint([]float64{1, 2})
+	line := primitives.NewLine(primitives.NewPoint([]float64{1, 2, 3}), primitives.NewPoint([]float64{1, 2, 3}))
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("DistancePointSegment(%v, %v) did not panic", point, line)
+		}
+	}()
+
+	geom.DistancePointSegment(point, line)
+}
@@ -0,0 +1,337 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package geomnd
+
+import (
+	"fmt"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/primitives"
+	"math"
+)
+
+// EuclidND represents a Euclidean geometric system of any dimension.
+// There is no cross product beyond three dimensions, so areas and distances are computed by
+// projecting vectors onto each other, which gives the same values as the cross product in 2D and 3D.
+type EuclidND struct {
+	dimension int
+	Decimate  *decimate.Decimate
+}
+
+// NewEuclid creates and returns a new instance of EuclidND with the given dimension.
+// It panics if the dimension is lower than one.
+//
+// Parameters:
+//   - dimension (int): The dimension of the geometry system.
+//
+// Returns:
+//   - *EuclidND: A new instance of the N-dimensional geometry system.
+func NewEuclid(dimension int) *EuclidND {
+	if dimension < 1 {
+		panic(fmt.Sprintf("EuclidND requires a dimension of at least 1, but it is %d\n", dimension))
+	}
+
+	e := &EuclidND{dimension: dimension}
+	e.Decimate = decimate.NewDecimate(*e)
+	return e
+}
+
+// Dimension returns the dimension of the geometry system.
+//
+// Returns:
+//   - int: The dimension given when the geometry was created.
+func (g EuclidND) Dimension() int {
+	return g.dimension
+}
+
+// checkVectors panics if any of the given vectors does not have the dimension of the geometry.
+//
+// Parameters:
+//   - operation (string): The name of the operation, used in the panic message.
+//   - v1 (*primitives.Vector): The first vector of the operation.
+//   - v2 (*primitives.Vector): The second vector of the operation.
+func (g EuclidND) checkVectors(operation string, v1, v2 *primitives.Vector) {
+	errorMsg := ""
+	if v1.Dimension() != g.Dimension() {
+		errorMsg += fmt.Sprintf("First vector is of dimension %d\n", v1.Dimension())
+	}
+	if v2.Dimension() != g.Dimension() {
+		errorMsg += fmt.Sprintf("Second vector is of dimension %d\n", v2.Dimension())
+	}
+	if errorMsg != "" {
+		errorMsg = fmt.Sprintf("%s in EuclidND only accepts vectors of dimension %d.\n %s", operation, g.Dimension(), errorMsg)
+		panic(errorMsg)
+	}
+}
+
+// checkPointLine panics if the point or any point of the line does not have the dimension of the geometry.
+//
+// Parameters:
+//   - operation (string): The name of the operation, used in the panic message.
+//   - point (*primitives.Point): The point of the operation.
+//   - line (*primitives.Line): The line of the operation.
+func (g EuclidND) checkPointLine(operation string, point *primitives.Point, line *primitives.Line) {
+	errorMsg := ""
+	if point.Dimension() != g.Dimension() {
+		errorMsg += fmt.Sprintf("Point is of dimension %d\n", point.Dimension())
+	}
+	if line.Point1.Dimension() != g.Dimension() || line.Point2.Dimension() != g.Dimension() {
+		errorMsg += fmt.Sprintf("Line has points of dimension %d and %d\n", line.Point1.Dimension(), line.Point2.Dimension())
+	}
+	if errorMsg != "" {
+		errorMsg = fmt.Sprintf("%s in EuclidND only accepts points of dimension %d.\n %s", operation, g.Dimension(), errorMsg)
+		panic(errorMsg)
+	}
+}
+
+// CrossProduct computes the exterior product of two vectors, the generalization of the cross
+// product to any dimension. The result holds the components v1[i]*v2[j] - v1[j]*v2[i] for every
+// pair i < j, so it has dimension N*(N-1)/2 and its norm is the area of the parallelogram formed
+// by the vectors. In 3D these are the components of the cross product, in a different order and sign.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The first vector to be used in the product.
+//   - v2 (*primitives.Vector): The second vector to be used in the product.
+//
+// Returns:
+//   - *primitives.Vector: The components of the exterior product of the input vectors.
+func (g EuclidND) CrossProduct(v1, v2 *primitives.Vector) *primitives.Vector {
+	g.checkVectors("CrossProduct", v1, v2)
+
+	// A vector of dimension 1 cannot be empty, and the exterior product of 1D vectors is zero.
+	result := make([]float64, max(g.dimension*(g.dimension-1)/2, 1))
+	k := 0
+	for i := 0; i < g.dimension; i++ {
+		for j := i + 1; j < g.dimension; j++ {
+			result[k] = v1.At(i, 0)*v2.At(j, 0) - v1.At(j, 0)*v2.At(i, 0)
+			k++
+		}
+	}
+
+	return primitives.NewVector(result)
+}
+
+// CrossProductNorm computes the area of the parallelogram formed by two vectors, the norm of their
+// exterior product. By the Lagrange identity it equals the norm of the second vector times the norm
+// of the component of the first vector orthogonal to it, which is how it is computed.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The first vector.
+//   - v2 (*primitives.Vector): The second vector.
+//
+// Returns:
+//   - float64: The area of the parallelogram formed by the vectors.
+func (g EuclidND) CrossProductNorm(v1, v2 *primitives.Vector) float64 {
+	g.checkVectors("CrossProductNorm", v1, v2)
+
+	a := v1.RawVector().Data
+	b := v2.RawVector().Data
+	return math.Sqrt(dot(b, b)) * rejectionNorm(a, b)
+}
+
+// DoubleAreaTriangle calculates the double of the area of a triangle formed by a point and a line.
+//
+// Parameters:
+//   - point (*primitives.Point): The point used to form the triangle.
+//   - line (*primitives.Line): The line forming the base of the triangle.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g EuclidND) DoubleAreaTriangle(point *primitives.Point, line *primitives.Line) float64 {
+	g.checkPointLine("DoubleAreaTriangle", point, line)
+	return g.DoubleAreaTriangleCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointLine computes the shortest distance from a point to a line, the norm of the
+// component of the vector from the line to the point orthogonal to the line. A line whose points
+// coincide has no direction, so the distance to that single point is returned instead.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the line is being calculated.
+//   - line (*primitives.Line): The line to which the distance is being measured.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the line.
+func (g EuclidND) DistancePointLine(point *primitives.Point, line *primitives.Line) float64 {
+	g.checkPointLine("DistancePointLine", point, line)
+	return g.DistancePointLineCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointSegment computes the shortest distance from a point to the segment between the
+// points of a line. The projection of the point is clamped to the segment, so points beyond its
+// ends are measured to the nearest end point. A segment of zero length is treated as a point.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the segment is being calculated.
+//   - line (*primitives.Line): The line whose points define the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the segment.
+func (g EuclidND) DistancePointSegment(point *primitives.Point, line *primitives.Line) float64 {
+	g.checkPointLine("DistancePointSegment", point, line)
+	return g.DistancePointSegmentCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DoubleAreaTriangleCoordinates calculates the double of the area of the triangle formed by a point
+// and the line going from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point used to form the triangle.
+//   - start ([]float64): The coordinates of the first point of the line.
+//   - end ([]float64): The coordinates of the second point of the line.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g EuclidND) DoubleAreaTriangleCoordinates(point, start, end []float64) float64 {
+	lengthSquared := distanceSquared(end, start)
+	if lengthSquared == 0 {
+		return 0
+	}
+	return math.Sqrt(lengthSquared) * g.DistancePointLineCoordinates(point, start, end)
+}
+
+// DistancePointLineCoordinates computes the shortest distance from a point to the line going from
+// start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point whose distance to the line is being calculated.
+//   - start ([]float64): The coordinates of the first point of the line.
+//   - end ([]float64): The coordinates of the second point of the line.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the line.
+func (g EuclidND) DistancePointLineCoordinates(point, start, end []float64) float64 {
+	projection, lengthSquared := project(point, start, end)
+	if lengthSquared == 0 {
+		return math.Sqrt(distanceSquared(point, start))
+	}
+	return orthogonalNorm(point, start, end, projection/lengthSquared)
+}
+
+// DistancePointSegmentCoordinates computes the shortest distance from a point to the segment going
+// from start to end, directly on the coordinates and without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point whose distance to the segment is being calculated.
+//   - start ([]float64): The coordinates of the first point of the segment.
+//   - end ([]float64): The coordinates of the second point of the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the segment.
+func (g EuclidND) DistancePointSegmentCoordinates(point, start, end []float64) float64 {
+	projection, lengthSquared := project(point, start, end)
+	if lengthSquared == 0 || projection <= 0 {
+		return math.Sqrt(distanceSquared(point, start))
+	}
+	if projection >= lengthSquared {
+		return math.Sqrt(distanceSquared(point, end))
+	}
+	return orthogonalNorm(point, start, end, projection/lengthSquared)
+}
+
+// dot computes the dot product of two coordinate slices.
+//
+// Parameters:
+//   - a ([]float64): The first coordinates.
+//   - b ([]float64): The second coordinates.
+//
+// Returns:
+//   - float64: The dot product of the coordinates.
+func dot(a, b []float64) float64 {
+	result := 0.0
+	for i := range a {
+		result += a[i] * b[i]
+	}
+	return result
+}
+
+// distanceSquared computes the squared distance between two points.
+//
+// Parameters:
+//   - a ([]float64): The coordinates of the first point.
+//   - b ([]float64): The coordinates of the second point.
+//
+// Returns:
+//   - float64: The squared distance between the points.
+func distanceSquared(a, b []float64) float64 {
+	result := 0.0
+	for i := range a {
+		result += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return result
+}
+
+// project computes the dot product of the vector from start to the point with the vector from
+// start to end, and the squared length of the latter.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the projected point.
+//   - start ([]float64): The coordinates of the first point of the line.
+//   - end ([]float64): The coordinates of the second point of the line.
+//
+// Returns:
+//   - float64: The dot product of both vectors.
+//   - float64: The squared length of the line.
+func project(point, start, end []float64) (float64, float64) {
+	projection := 0.0
+	lengthSquared := 0.0
+	for i := range point {
+		director := end[i] - start[i]
+		projection += (point[i] - start[i]) * director
+		lengthSquared += director * director
+	}
+	return projection, lengthSquared
+}
+
+// orthogonalNorm computes the norm of the vector from the point at parameter t of the line going
+// from start to end to the given point.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point.
+//   - start ([]float64): The coordinates of the first point of the line.
+//   - end ([]float64): The coordinates of the second point of the line.
+//   - t (float64): The parameter of the projection of the point on the line.
+//
+// Returns:
+//   - float64: The norm of the vector.
+func orthogonalNorm(point, start, end []float64, t float64) float64 {
+	result := 0.0
+	for i := range point {
+		component := point[i] - start[i] - t*(end[i]-start[i])
+		result += component * component
+	}
+	return math.Sqrt(result)
+}
+
+// rejectionNorm computes the norm of the component of a orthogonal to b. When b is zero the norm
+// of a is returned.
+//
+// Parameters:
+//   - a ([]float64): The rejected vector.
+//   - b ([]float64): The vector a is rejected from.
+//
+// Returns:
+//   - float64: The norm of the rejection of a from b.
+func rejectionNorm(a, b []float64) float64 {
+	lengthSquared := dot(b, b)
+	if lengthSquared == 0 {
+		return math.Sqrt(dot(a, a))
+	}
+	t := dot(a, b) / lengthSquared
+	result := 0.0
+	for i := range a {
+		component := a[i] - t*b[i]
+		result += component * component
+	}
+	return math.Sqrt(result)
+}
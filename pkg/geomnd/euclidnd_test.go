@@ -0,0 +1,245 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package geomnd
+
+import (
+	"github.com/cenieto/decimate/pkg/primitives"
+	"github.com/cenieto/decimate/pkg/testutils"
+	"math"
+	"testing"
+)
+
+// TestGeomndInstantiation tests the correct instantiation of a geomnd object.
+func TestGeomndInstantiation(t *testing.T) {
+	for _, dimension := range []int{1, 2, 3, 4, 7} {
+		geomnd := NewEuclid(dimension)
+
+		if geomnd.Dimension() != dimension {
+			t.Errorf("geomnd.Dimension() = %v; want %v", geomnd.Dimension(), dimension)
+		}
+		if geomnd.Decimate.Geometry.Dimension() != dimension {
+			t.Errorf("geomnd.Decimate.Geometry.Dimension() = %v; want %v", geomnd.Decimate.Geometry.Dimension(), dimension)
+		}
+	}
+}
+
+// TestGeomndInstantiationInvalid tests the instantiation of a geomnd object with an invalid dimension.
+// Verifies that the constructor panics.
+func TestGeomndInstantiationInvalid(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("NewEuclid(0) did not panic")
+		}
+	}()
+
+	NewEuclid(0)
+}
+
+// TestCrossProductND tests the exterior product of two vectors.
+// Verifies that its norm matches the norm of the 3D cross product and the value of CrossProductNorm.
+// Set of inputs and expected results are read from a CSV file.
+func TestCrossProductND(t *testing.T) {
+	fixtureFile := "../../testdata/geom3d/cross-product.csv"
+	reader, err := testutils.NewCSVFloat64Reader(fixtureFile)
+	if err != nil {
+		t.Fatalf("Error while opening CSV file: %v", err)
+	}
+
+	lines := reader.ReadLines()
+
+	geom := NewEuclid(3)
+	for values := range lines {
+		if values.Err != nil {
+			t.Fatalf("Error while reading CSV file: %v", values.Err)
+		}
+
+		v1 := primitives.NewVector(values.Values[0:3])
+		v2 := primitives.NewVector(values.Values[3:6])
+		expected := values.Values[9]
+
+		result := geom.CrossProduct(v1, v2)
+		if result.Dimension() != 3 {
+			t.Errorf("CrossProduct(%v, %v) has dimension %v; want %v", v1, v2, result.Dimension(), 3)
+		}
+		if math.Abs(result.Length()-expected) > testutils.TestToleranceAbsolute {
+			t.Errorf("CrossProduct(%v, %v).Length() = %v; want %v", v1, v2, result.Length(), expected)
+		}
+
+		norm := geom.CrossProductNorm(v1, v2)
+		if math.Abs(norm-expected) > testutils.TestToleranceAbsolute {
+			t.Errorf("CrossProductNorm(%v, %v) = %v; want %v", v1, v2, norm, expected)
+		}
+	}
+
+	v1 := primitives.NewVector([]float64{1, 0, 0, 0})
+	v2 := primitives.NewVector([]float64{0, 0, 0, 2})
+	if result := NewEuclid(4).CrossProduct(v1, v2); result.Dimension() != 6 || result.Length() != 2 {
+		t.Errorf("CrossProduct(%v, %v) = %v; want a vector of dimension 6 and norm 2", v1, v2, result)
+	}
+}
+
+// TestCrossProductNDInvalid tests the CrossProduct and CrossProductNorm methods with invalid inputs.
+// Verifies that the methods panic when the vectors do not have the dimension of the geometry.
+func TestCrossProductNDInvalid(t *testing.T) {
+	geom := NewEuclid(4)
+	v1 := primitives.NewVector([]float64{1, 2, 3, 4})
+	v2 := primitives.NewVector([]float64{1, 2, 3})
+
+	tests := map[string]func(){
+		"CrossProduct":     func() { geom.CrossProduct(v1, v2) },
+		"CrossProductNorm": func() { geom.CrossProductNorm(v2, v1) },
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%v(%v, %v) did not panic", name, v1, v2)
+				}
+			}()
+			call()
+		})
+	}
+}
+
+// TestDistancePointLineND tests the calculation of the double area of a triangle and the distance from
+// a point to a line, in the dimensions of the geom2d and geom3d fixtures and in 4D.
+// Verifies that the primitive and the coordinates forms return the same values.
+// Set of inputs and expected results are read from CSV files.
+func TestDistancePointLineND(t *testing.T) {
+	tests := []struct {
+		dimension   int
+		fixtureFile string
+	}{
+		{2, "../../testdata/geom2d/point-line.csv"},
+		{3, "../../testdata/geom3d/point-line.csv"},
+		{4, "../../testdata/geomnd/point-line-4d.csv"},
+	}
+
+	for _, tt := range tests {
+		reader, err := testutils.NewCSVFloat64Reader(tt.fixtureFile)
+		if err != nil {
+			t.Fatalf("Error while opening CSV file: %v", err)
+		}
+
+		geom := NewEuclid(tt.dimension)
+		n := tt.dimension
+		for values := range reader.ReadLines() {
+			if values.Err != nil {
+				t.Fatalf("Error while reading CSV file: %v", values.Err)
+			}
+
+			point := values.Values[0:n]
+			start := values.Values[n : 2*n]
+			end := values.Values[2*n : 3*n]
+			line := primitives.NewLine(primitives.NewPoint(start), primitives.NewPoint(end))
+
+			area := geom.DoubleAreaTriangle(primitives.NewPoint(point), line)
+			if math.Abs(area-values.Values[3*n]) > testutils.TestToleranceAbsolute {
+				t.Errorf("DoubleAreaTriangle(%v, %v) = %v; want %v", point, line.String(), area, values.Values[3*n])
+			}
+			if area != geom.DoubleAreaTriangleCoordinates(point, start, end) {
+				t.Errorf("DoubleAreaTriangleCoordinates(%v, %v, %v) = %v; want %v", point, start, end, geom.DoubleAreaTriangleCoordinates(point, start, end), area)
+			}
+
+			distance := geom.DistancePointLine(primitives.NewPoint(point), line)
+			if math.Abs(distance-values.Values[3*n+1]) > testutils.TestToleranceAbsolute {
+				t.Errorf("DistancePointLine(%v, %v) = %v; want %v", point, line.String(), distance, values.Values[3*n+1])
+			}
+			if distance != geom.DistancePointLineCoordinates(point, start, end) {
+				t.Errorf("DistancePointLineCoordinates(%v, %v, %v) = %v; want %v", point, start, end, geom.DistancePointLineCoordinates(point, start, end), distance)
+			}
+		}
+	}
+}
+
+// TestDistancePointLineNDDegenerate tests the DistancePointLine method with a line whose points coincide.
+// Verifies that the distance to that single point is returned and that the triangle has no area.
+func TestDistancePointLineNDDegenerate(t *testing.T) {
+	geom := NewEuclid(4)
+	point := primitives.NewPoint([]float64{1, 2, 2, 4})
+	line := primitives.NewLine(primitives.NewPoint([]float64{0, 0, 0, 0}), primitives.NewPoint([]float64{0, 0, 0, 0}))
+
+	if result := geom.DistancePointLine(point, line); result != 5 {
+		t.Errorf("DistancePointLine(%v, %v) = %v; want %v", point.String(), line.String(), result, 5)
+	}
+	if result := geom.DoubleAreaTriangle(point, line); result != 0 {
+		t.Errorf("DoubleAreaTriangle(%v, %v) = %v; want %v", point.String(), line.String(), result, 0)
+	}
+}
+
+// TestDistancePointSegmentND tests the calculation of the distance from a point to a segment, in the
+// dimensions of the geom2d and geom3d fixtures and in 4D.
+// Verifies that the projection of the point is clamped to the segment, including segments of zero length.
+// Set of inputs and expected results are read from CSV files.
+func TestDistancePointSegmentND(t *testing.T) {
+	tests := []struct {
+		dimension   int
+		fixtureFile string
+	}{
+		{2, "../../testdata/geom2d/point-segment.csv"},
+		{3, "../../testdata/geom3d/point-segment.csv"},
+		{4, "../../testdata/geomnd/point-segment-4d.csv"},
+	}
+
+	for _, tt := range tests {
+		reader, err := testutils.NewCSVFloat64Reader(tt.fixtureFile)
+		if err != nil {
+			t.Fatalf("Error while opening CSV file: %v", err)
+		}
+
+		geom := NewEuclid(tt.dimension)
+		n := tt.dimension
+		for values := range reader.ReadLines() {
+			if values.Err != nil {
+				t.Fatalf("Error while reading CSV file: %v", values.Err)
+			}
+
+			point := primitives.NewPoint(values.Values[0:n])
+			line := primitives.NewLine(primitives.NewPoint(values.Values[n:2*n]), primitives.NewPoint(values.Values[2*n:3*n]))
+
+			result := geom.DistancePointSegment(point, line)
+			expected := values.Values[3*n]
+			if math.Abs(result-expected) > testutils.TestToleranceAbsolute {
+				t.Errorf("DistancePointSegment(%v, %v) = %v; want %v", point.String(), line.String(), result, expected)
+			}
+		}
+	}
+}
+
+// TestDistancePointLineNDInvalid tests the DoubleAreaTriangle, DistancePointLine and DistancePointSegment
+// methods with invalid inputs.
+// Verifies that the methods panic when the points do not have the dimension of the geometry.
+func TestDistancePointLineNDInvalid(t *testing.T) {
+	geom := NewEuclid(4)
+	point := primitives.NewPoint([]float64{1, 2, 3})
+	line := primitives.NewLine(primitives.NewPoint([]float64{1, 2, 3, 4}), primitives.NewPoint([]float64{4, 3, 2, 1}))
+
+	tests := map[string]func(){
+		"DoubleAreaTriangle":   func() { geom.DoubleAreaTriangle(point, line) },
+		"DistancePointLine":    func() { geom.DistancePointLine(point, line) },
+		"DistancePointSegment": func() { geom.DistancePointSegment(point, line) },
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%v(%v, %v) did not panic", name, point.String(), line.String())
+				}
+			}()
+			call()
+		})
+	}
+}
@@ -24,4 +24,23 @@ type Geometry interface {
 	CrossProductNorm(*primitives.Vector, *primitives.Vector) float64
 	DoubleAreaTriangle(*primitives.Point, *primitives.Line) float64
 	DistancePointLine(*primitives.Point, *primitives.Line) float64
+	DistancePointSegment(*primitives.Point, *primitives.Line) float64
+}
+
+// CoordinateGeometry is an optional extension of Geometry for geometries able to operate directly
+// on coordinate slices. Decimation algorithms use it, when available, to avoid allocating
+// primitives for every point they visit. Its methods must return the same values as their
+// Geometry counterparts, where the line goes from start to end.
+type CoordinateGeometry interface {
+	DoubleAreaTriangleCoordinates(point, start, end []float64) float64
+	DistancePointLineCoordinates(point, start, end []float64) float64
+	DistancePointSegmentCoordinates(point, start, end []float64) float64
+}
+
+// SynchronizedGeometry is an optional extension of Geometry for trajectories, whose points carry
+// a timestamp. Decimation algorithms for trajectories, such as TDTR, require it to measure the
+// error of a point against the position interpolated at its time on the simplified segment.
+type SynchronizedGeometry interface {
+	Time(point []float64) float64
+	SynchronizedDistanceCoordinates(point, start, end []float64) float64
 }
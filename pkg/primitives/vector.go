@@ -16,6 +16,7 @@ package primitives
 import (
 	"fmt"
 	"gonum.org/v1/gonum/mat"
+	"math"
 )
 
 // Vector represents a vector, which is a mathematical entity defined by its components.
@@ -89,3 +90,39 @@ func (v Vector) Length() float64 {
 func (v Vector) Dimension() int {
 	return len(v.RawVector().Data)
 }
+
+// Norm computes the Euclidean length (2-norm) of a list of coordinates.
+//
+// It uses the same scaled algorithm as gonum, so it returns exactly the same value as the Length
+// of a Vector with those coordinates, but it works on a plain slice and does not allocate.
+//
+// Arguments:
+//   - coordinates ([]float64): The components of the vector.
+//
+// Returns:
+//   - float64: The Euclidean length of the vector.
+func Norm(coordinates []float64) float64 {
+	scale := 0.0
+	sumSquares := 1.0
+	for _, value := range coordinates {
+		if value == 0 {
+			continue
+		}
+		absolute := math.Abs(value)
+		if math.IsNaN(absolute) {
+			return math.NaN()
+		}
+		if scale < absolute {
+			ratio := scale / absolute
+			sumSquares = 1 + sumSquares*ratio*ratio
+			scale = absolute
+		} else {
+			ratio := absolute / scale
+			sumSquares += ratio * ratio
+		}
+	}
+	if math.IsInf(scale, 1) {
+		return math.Inf(1)
+	}
+	return scale * math.Sqrt(sumSquares)
+}
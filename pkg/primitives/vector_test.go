@@ -112,3 +112,23 @@ func TestVectorDimension(t *testing.T) {
 		t.Errorf("vector.Dimension() = %v; want %v", result, expected)
 	}
 }
+
+// TestNorm checks that Norm returns exactly the same value as the Length of a Vector.
+func TestNorm(t *testing.T) {
+	inputs := [][]float64{
+		{0.0, 0.0},
+		{1.0, 1.0},
+		{0.0, 0.0, -3.5},
+		{1e-300, 3e-300, -2e-300},
+		{0.1, 0.7, 0.3, 1e10},
+		{-0.4021817903821243, 0.605849365582456, 0.17539822016223117},
+	}
+
+	for _, input := range inputs {
+		result := Norm(input)
+		expected := NewVector(input).Length()
+		if result != expected {
+			t.Errorf("Norm(%v) = %v; want %v", input, result, expected)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package projected
+
+import (
+	"fmt"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geom2d"
+	"github.com/cenieto/decimate/pkg/primitives"
+)
+
+// Projection maps WGS84 longitude and latitude to planar coordinates.
+type Projection interface {
+	Project(lonLat []float64) [2]float64
+}
+
+// Euclid represents a 2D Euclidean geometry in the plane of a map projection.
+// Points are given as WGS84 longitude and latitude in degrees, in that order, and projected on the
+// fly to compute areas and distances with geom2d.Euclid2D. Decimation algorithms only select
+// points, so their output stays in longitude and latitude, while their tolerances are expressed
+// in the unit of the projection.
+type Euclid struct {
+	Projection Projection
+	Decimate   *decimate.Decimate
+}
+
+// NewEuclid creates and returns a new instance of Euclid with the given projection.
+//
+// Parameters:
+//   - projection (Projection): The projection mapping longitude and latitude to the plane.
+//
+// Returns:
+//   - *Euclid: A new instance of the projected geometry system.
+func NewEuclid(projection Projection) *Euclid {
+	e := &Euclid{Projection: projection}
+	e.Decimate = decimate.NewDecimate(*e)
+	return e
+}
+
+// Dimension returns the dimension of the geometry system.
+//
+// Returns:
+//   - int: The dimension of the geometry, which is always 2 for this system, longitude and latitude.
+func (g Euclid) Dimension() int {
+	return 2
+}
+
+// CrossProduct computes the cross product of the projections of two positions, given as vectors
+// of longitude and latitude.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The longitude and latitude of the first position.
+//   - v2 (*primitives.Vector): The longitude and latitude of the second position.
+//
+// Returns:
+//   - *primitives.Vector: A 3D vector where the Z-component is the cross product of the projections.
+func (g Euclid) CrossProduct(v1, v2 *primitives.Vector) *primitives.Vector {
+	checkVectors("CrossProduct", v1, v2)
+
+	p1 := g.Projection.Project(v1.RawVector().Data)
+	p2 := g.Projection.Project(v2.RawVector().Data)
+	return geom2d.Euclid2D{}.CrossProduct(primitives.NewVector(p1[:]), primitives.NewVector(p2[:]))
+}
+
+// CrossProductNorm computes the norm of the cross product of the projections of two positions.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The longitude and latitude of the first position.
+//   - v2 (*primitives.Vector): The longitude and latitude of the second position.
+//
+// Returns:
+//   - float64: The norm of the cross product of the projections.
+func (g Euclid) CrossProductNorm(v1, v2 *primitives.Vector) float64 {
+	checkVectors("CrossProductNorm", v1, v2)
+
+	p1 := g.Projection.Project(v1.RawVector().Data)
+	p2 := g.Projection.Project(v2.RawVector().Data)
+	return geom2d.Euclid2D{}.CrossProductNorm(primitives.NewVector(p1[:]), primitives.NewVector(p2[:]))
+}
+
+// DoubleAreaTriangle calculates the double of the area of the projection of a triangle formed by a
+// point and a line.
+//
+// Parameters:
+//   - point (*primitives.Point): The point used to form the triangle.
+//   - line (*primitives.Line): The line forming the base of the triangle.
+//
+// Returns:
+//   - float64: The double of the triangle's area, in the squared unit of the projection.
+func (g Euclid) DoubleAreaTriangle(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DoubleAreaTriangle", point, line)
+	return g.DoubleAreaTriangleCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointLine computes the shortest distance from the projection of a point to the
+// projection of a line.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the line is being calculated.
+//   - line (*primitives.Line): The line to which the distance is being measured.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the line, in the unit of the projection.
+func (g Euclid) DistancePointLine(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DistancePointLine", point, line)
+	return g.DistancePointLineCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointSegment computes the shortest distance from the projection of a point to the
+// projection of the segment between the points of a line.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the segment is being calculated.
+//   - line (*primitives.Line): The line whose points define the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the segment, in the unit of the projection.
+func (g Euclid) DistancePointSegment(point *primitives.Point, line *primitives.Line) float64 {
+	checkPointLine("DistancePointSegment", point, line)
+	return g.DistancePointSegmentCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DoubleAreaTriangleCoordinates calculates the double of the area of the projection of the triangle
+// formed by a point and the line going from start to end, without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point used to form the triangle.
+//   - start ([]float64): The longitude and latitude of the first point of the line.
+//   - end ([]float64): The longitude and latitude of the second point of the line.
+//
+// Returns:
+//   - float64: The double of the triangle's area, in the squared unit of the projection.
+func (g Euclid) DoubleAreaTriangleCoordinates(point, start, end []float64) float64 {
+	p, a, b := g.Projection.Project(point), g.Projection.Project(start), g.Projection.Project(end)
+	return geom2d.Euclid2D{}.DoubleAreaTriangleCoordinates(p[:], a[:], b[:])
+}
+
+// DistancePointLineCoordinates computes the shortest distance from the projection of a point to the
+// projection of the line going from start to end, without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point whose distance is being calculated.
+//   - start ([]float64): The longitude and latitude of the first point of the line.
+//   - end ([]float64): The longitude and latitude of the second point of the line.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the line, in the unit of the projection.
+func (g Euclid) DistancePointLineCoordinates(point, start, end []float64) float64 {
+	p, a, b := g.Projection.Project(point), g.Projection.Project(start), g.Projection.Project(end)
+	return geom2d.Euclid2D{}.DistancePointLineCoordinates(p[:], a[:], b[:])
+}
+
+// DistancePointSegmentCoordinates computes the shortest distance from the projection of a point to
+// the projection of the segment going from start to end, without allocating primitives.
+//
+// Parameters:
+//   - point ([]float64): The longitude and latitude of the point whose distance is being calculated.
+//   - start ([]float64): The longitude and latitude of the first point of the segment.
+//   - end ([]float64): The longitude and latitude of the second point of the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the segment, in the unit of the projection.
+func (g Euclid) DistancePointSegmentCoordinates(point, start, end []float64) float64 {
+	p, a, b := g.Projection.Project(point), g.Projection.Project(start), g.Projection.Project(end)
+	return geom2d.Euclid2D{}.DistancePointSegmentCoordinates(p[:], a[:], b[:])
+}
+
+// checkVectors panics if any of the given vectors is not made of a longitude and a latitude.
+//
+// Parameters:
+//   - operation (string): The name of the operation, used in the panic message.
+//   - v1 (*primitives.Vector): The first vector of the operation.
+//   - v2 (*primitives.Vector): The second vector of the operation.
+func checkVectors(operation string, v1, v2 *primitives.Vector) {
+	errorMsg := ""
+	if v1.Dimension() != 2 {
+		errorMsg += fmt.Sprintf("First vector is not 2D, is of dimension %d\n", v1.Dimension())
+	}
+	if v2.Dimension() != 2 {
+		errorMsg += fmt.Sprintf("Second vector is not 2D, is of dimension %d\n", v2.Dimension())
+	}
+	if errorMsg != "" {
+		errorMsg = fmt.Sprintf("%s in projected Euclid only accepts longitude and latitude vectors.\n %s", operation, errorMsg)
+		panic(errorMsg)
+	}
+}
+
+// checkPointLine panics if the point or any point of the line is not made of a longitude and a latitude.
+//
+// Parameters:
+//   - operation (string): The name of the operation, used in the panic message.
+//   - point (*primitives.Point): The point of the operation.
+//   - line (*primitives.Line): The line of the operation.
+func checkPointLine(operation string, point *primitives.Point, line *primitives.Line) {
+	errorMsg := ""
+	if point.Dimension() != 2 {
+		errorMsg += fmt.Sprintf("Point is not 2D, is of dimension %d\n", point.Dimension())
+	}
+	if line.Point1.Dimension() != 2 || line.Point2.Dimension() != 2 {
+		errorMsg += fmt.Sprintf("Line is not 2D, has points of dimension %d and %d\n", line.Point1.Dimension(), line.Point2.Dimension())
+	}
+	if errorMsg != "" {
+		errorMsg = fmt.Sprintf("%s in projected Euclid only accepts longitude and latitude points.\n %s", operation, errorMsg)
+		panic(errorMsg)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package projected
+
+import (
+	"errors"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geodesic"
+	"github.com/cenieto/decimate/pkg/primitives"
+	"math"
+	"testing"
+)
+
+// millimeter is the absolute tolerance used to compare projected coordinates, in meters.
+const millimeter = 1e-3
+
+// TestTransverseMercatorProject tests the projection of points to UTM coordinates.
+// Verifies them against reference coordinates and the false easting and northing of the zones.
+func TestTransverseMercatorProject(t *testing.T) {
+	tests := []struct {
+		name     string
+		zone     int
+		north    bool
+		lonLat   []float64
+		expected [2]float64
+	}{
+		{"Reference", 38, true, []float64{44.4, 33.3}, [2]float64{444140.545, 3684706.356}},
+		{"ReferenceSouth", 38, false, []float64{44.4, -33.3}, [2]float64{444140.545, 10000000 - 3684706.356}},
+		{"CentralMeridian", 31, true, []float64{3, 0}, [2]float64{500000, 0}},
+		// Four degrees east of the central meridian, across the antimeridian.
+		{"Antimeridian", 60, true, []float64{-179, 10}, UTMProjection(1, true).Project([]float64{-173, 10})},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := UTMProjection(test.zone, test.north).Project(test.lonLat)
+			if math.Abs(result[0]-test.expected[0]) > millimeter || math.Abs(result[1]-test.expected[1]) > millimeter {
+				t.Errorf("Project(%v) = %v; want %v", test.lonLat, result, test.expected)
+			}
+		})
+	}
+}
+
+// TestUTMZone tests the selection of the UTM zone of a point.
+// Verifies the regular zones, the antimeridian and the exceptions of Norway and Svalbard.
+func TestUTMZone(t *testing.T) {
+	tests := []struct {
+		longitude float64
+		latitude  float64
+		zone      int
+		north     bool
+	}{
+		{3, 0, 31, true},
+		{-3.7, 40.4, 30, true},
+		{151.2, -33.9, 56, false},
+		{-180, 10, 1, true},
+		{179.9, -10, 60, false},
+		{540, 10, 1, true},
+		{5.3, 60.4, 32, true},
+		{15.6, 78.2, 33, true},
+		{25, 78, 35, true},
+	}
+
+	for _, test := range tests {
+		zone, north := UTMZone(test.longitude, test.latitude)
+		if zone != test.zone || north != test.north {
+			t.Errorf("UTMZone(%v, %v) = %v, %v; want %v, %v", test.longitude, test.latitude, zone, north, test.zone, test.north)
+		}
+	}
+}
+
+// TestNewUTMFor tests the creation of a UTM geometry for a list of points.
+// Verifies that the zone of the center of the points is used, also across the antimeridian, and
+// that invalid inputs return errors.
+func TestNewUTMFor(t *testing.T) {
+	geometry, err := NewUTMFor([][]float64{{-3.8, 40.3}, {-3.6, 40.5}})
+	if err != nil {
+		t.Fatalf("NewUTMFor() returned an error: %v", err)
+	}
+	if geometry.Projection != UTMProjection(30, true) {
+		t.Errorf("NewUTMFor() projection = %v; want %v", geometry.Projection, UTMProjection(30, true))
+	}
+
+	geometry, err = NewUTMFor([][]float64{{179.9, -17}, {-179.7, -17.1}})
+	if err != nil {
+		t.Fatalf("NewUTMFor() returned an error: %v", err)
+	}
+	if geometry.Projection != UTMProjection(1, false) {
+		t.Errorf("NewUTMFor() projection = %v; want %v", geometry.Projection, UTMProjection(1, false))
+	}
+
+	if _, err := NewUTMFor(nil); !errors.Is(err, decimate.ErrTooFewPoints) {
+		t.Errorf("NewUTMFor(nil) error = %v; want %v", err, decimate.ErrTooFewPoints)
+	}
+	if _, err := NewUTMFor([][]float64{{1, 2, 3}}); !errors.Is(err, decimate.ErrDimensionMismatch) {
+		t.Errorf("NewUTMFor() error = %v; want %v", err, decimate.ErrDimensionMismatch)
+	}
+}
+
+// TestNewUTMInvalid tests the creation of a UTM geometry with an invalid zone.
+// Verifies that the constructor panics.
+func TestNewUTMInvalid(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("NewUTM(61, true) did not panic")
+		}
+	}()
+
+	NewUTM(61, true)
+}
+
+// TestWebMercatorProject tests the projection of points to EPSG:3857 coordinates and pixels.
+// Verifies the corners of the web map and the size of the world at a zoom level.
+func TestWebMercatorProject(t *testing.T) {
+	corner := 20037508.342789244
+
+	result := WebMercator{Scale: 1}.Project([]float64{180, 89})
+	if math.Abs(result[0]-corner) > millimeter || math.Abs(result[1]-corner) > millimeter {
+		t.Errorf("Project(%v) = %v; want %v", []float64{180, 89}, result, [2]float64{corner, corner})
+	}
+
+	for _, zoom := range []float64{0, 1, 12.5} {
+		projection := NewWebMercatorPixels(zoom).Projection
+		result := projection.Project([]float64{180, 0})
+		expected := TileSize * math.Exp2(zoom) / 2
+		if math.Abs(result[0]-expected) > 1e-9*expected {
+			t.Errorf("Project(%v) at zoom %v = %v; want %v", []float64{180, 0}, zoom, result[0], expected)
+		}
+	}
+}
+
+// TestEuclidDistancePointLine tests the distances computed in the plane of the projections.
+// Verifies that UTM distances match the geodesic distances on WGS84 up to the scale factor of the
+// projection, and that the primitive and the coordinates forms return the same values.
+func TestEuclidDistancePointLine(t *testing.T) {
+	wgs84 := geodesic.NewWGS84()
+	utm := NewUTM(30, true)
+
+	point := []float64{-3.70, 40.42}
+	start := []float64{-3.72, 40.40}
+	end := []float64{-3.66, 40.41}
+	line := primitives.NewLine(primitives.NewPoint(start), primitives.NewPoint(end))
+
+	distance := utm.DistancePointLine(primitives.NewPoint(point), line)
+	expected := wgs84.DistancePointLineCoordinates(point, start, end)
+	if math.Abs(distance-expected) > 1e-3*expected {
+		t.Errorf("DistancePointLine(%v, %v) = %v; want %v", point, line.String(), distance, expected)
+	}
+	if distance != utm.DistancePointLineCoordinates(point, start, end) {
+		t.Errorf("DistancePointLineCoordinates(%v, %v, %v) = %v; want %v", point, start, end, utm.DistancePointLineCoordinates(point, start, end), distance)
+	}
+
+	area := utm.DoubleAreaTriangle(primitives.NewPoint(point), line)
+	if area != utm.DoubleAreaTriangleCoordinates(point, start, end) {
+		t.Errorf("DoubleAreaTriangleCoordinates(%v, %v, %v) = %v; want %v", point, start, end, utm.DoubleAreaTriangleCoordinates(point, start, end), area)
+	}
+
+	segment := utm.DistancePointSegment(primitives.NewPoint(point), line)
+	if segment != utm.DistancePointSegmentCoordinates(point, start, end) || segment != distance {
+		t.Errorf("DistancePointSegment(%v, %v) = %v; want %v", point, line.String(), segment, distance)
+	}
+
+	// A point one degree of longitude east of the end of the segment, along the equator.
+	mercator := NewWebMercator()
+	segment = mercator.DistancePointSegmentCoordinates([]float64{2, 0}, []float64{0, 0}, []float64{1, 0})
+	if math.Abs(segment-6378137*math.Pi/180) > millimeter {
+		t.Errorf("DistancePointSegmentCoordinates() = %v; want %v", segment, 6378137*math.Pi/180)
+	}
+}
+
+// TestEuclidInvalid tests the methods of Euclid with invalid inputs.
+// Verifies that the methods panic when the points are not made of a longitude and a latitude.
+func TestEuclidInvalid(t *testing.T) {
+	geom := NewWebMercator()
+	point := primitives.NewPoint([]float64{1, 2, 3})
+	line := primitives.NewLine(primitives.NewPoint([]float64{1, 2}), primitives.NewPoint([]float64{3, 4}))
+	vector := primitives.NewVector([]float64{1, 2, 3})
+
+	tests := map[string]func(){
+		"CrossProduct":         func() { geom.CrossProduct(vector, vector) },
+		"CrossProductNorm":     func() { geom.CrossProductNorm(vector, vector) },
+		"DoubleAreaTriangle":   func() { geom.DoubleAreaTriangle(point, line) },
+		"DistancePointLine":    func() { geom.DistancePointLine(point, line) },
+		"DistancePointSegment": func() { geom.DistancePointSegment(point, line) },
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%v did not panic", name)
+				}
+			}()
+			call()
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package projected
+
+import (
+	"fmt"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"math"
+)
+
+const (
+	wgs84SemiMajorAxis = 6378137.0         // Equatorial radius of WGS84, in meters
+	wgs84Flattening    = 1 / 298.257223563 // Flattening of WGS84
+	utmScaleFactor     = 0.9996            // Scale factor of UTM on the central meridian
+	utmFalseEasting    = 500000.0          // Easting of the central meridian, in meters
+	utmFalseNorthing   = 10000000.0        // Northing of the equator in the southern hemisphere, in meters
+)
+
+// TransverseMercator is the transverse Mercator projection of the WGS84 ellipsoid, computed with
+// the series of Krüger to the third order of the flattening, accurate to the millimeter within
+// a UTM zone. Coordinates are in meters, scaled by ScaleFactor on the central meridian.
+type TransverseMercator struct {
+	CentralMeridian float64 // Longitude of the central meridian, in degrees
+	ScaleFactor     float64 // Scale factor on the central meridian
+	FalseEasting    float64 // Easting of the central meridian, in meters
+	FalseNorthing   float64 // Northing of the equator, in meters
+}
+
+// UTMProjection returns the transverse Mercator projection of a UTM zone.
+// It panics if the zone is not between 1 and 60.
+//
+// Parameters:
+//   - zone (int): The number of the UTM zone, from 1 to 60.
+//   - north (bool): True for the northern hemisphere, false for the southern one.
+//
+// Returns:
+//   - TransverseMercator: The projection of the zone.
+func UTMProjection(zone int, north bool) TransverseMercator {
+	if zone < 1 || zone > 60 {
+		panic(fmt.Sprintf("UTM zones go from 1 to 60, but zone is %d\n", zone))
+	}
+
+	projection := TransverseMercator{
+		CentralMeridian: float64(6*zone - 183),
+		ScaleFactor:     utmScaleFactor,
+		FalseEasting:    utmFalseEasting,
+	}
+	if !north {
+		projection.FalseNorthing = utmFalseNorthing
+	}
+	return projection
+}
+
+// UTMZone returns the UTM zone of a point, including the exceptions of southwestern Norway and
+// Svalbard.
+//
+// Parameters:
+//   - longitude (float64): The longitude of the point, in degrees.
+//   - latitude (float64): The latitude of the point, in degrees.
+//
+// Returns:
+//   - int: The number of the UTM zone, from 1 to 60.
+//   - bool: True if the point is in the northern hemisphere.
+func UTMZone(longitude, latitude float64) (int, bool) {
+	longitude = math.Remainder(longitude, 360)
+	zone := int(math.Floor((longitude+180)/6))%60 + 1
+
+	if latitude >= 56 && latitude < 64 && longitude >= 3 && longitude < 12 {
+		zone = 32
+	}
+	if latitude >= 72 && longitude >= 0 && longitude < 42 {
+		switch {
+		case longitude < 9:
+			zone = 31
+		case longitude < 21:
+			zone = 33
+		case longitude < 33:
+			zone = 35
+		default:
+			zone = 37
+		}
+	}
+
+	return zone, latitude >= 0
+}
+
+// NewUTM creates and returns a new projected geometry using the given UTM zone, where tolerances
+// of the decimation algorithms are distances in meters, accurate to the scale factor of UTM,
+// which stays within 0.1% of one inside the zone. It panics if the zone is not between 1 and 60.
+//
+// Parameters:
+//   - zone (int): The number of the UTM zone, from 1 to 60.
+//   - north (bool): True for the northern hemisphere, false for the southern one.
+//
+// Returns:
+//   - *Euclid: A new instance of the projected geometry system.
+func NewUTM(zone int, north bool) *Euclid {
+	return NewEuclid(UTMProjection(zone, north))
+}
+
+// NewUTMFor creates and returns a new projected geometry using the UTM zone of the center of the
+// given points, where tolerances of the decimation algorithms are distances in meters.
+//
+// Parameters:
+//   - points ([][]float64): The longitude and latitude of the points to be decimated.
+//
+// Returns:
+//   - *Euclid: A new instance of the projected geometry system.
+//   - error: An error wrapping decimate.ErrTooFewPoints if there are no points, or
+//     decimate.ErrDimensionMismatch if a point is not made of a longitude and a latitude.
+func NewUTMFor(points [][]float64) (*Euclid, error) {
+	if len(points) == 0 {
+		return nil, fmt.Errorf("%w: the UTM zone of an empty point list is not defined", decimate.ErrTooFewPoints)
+	}
+
+	// The center is the mean of the unit vectors of the points, which is valid across the antimeridian.
+	var x, y, z float64
+	for i, point := range points {
+		if len(point) != 2 {
+			return nil, fmt.Errorf("%w: point at position %v has dimension %v, but longitude and latitude are expected", decimate.ErrDimensionMismatch, i, len(point))
+		}
+		longitude := point[0] * math.Pi / 180
+		latitude := point[1] * math.Pi / 180
+		x += math.Cos(latitude) * math.Cos(longitude)
+		y += math.Cos(latitude) * math.Sin(longitude)
+		z += math.Sin(latitude)
+	}
+
+	longitude := math.Atan2(y, x) * 180 / math.Pi
+	latitude := math.Atan2(z, math.Hypot(x, y)) * 180 / math.Pi
+	return NewUTM(UTMZone(longitude, latitude)), nil
+}
+
+// Project maps WGS84 longitude and latitude to transverse Mercator easting and northing.
+//
+// Parameters:
+//   - lonLat ([]float64): The longitude and latitude of the point, in degrees.
+//
+// Returns:
+//   - [2]float64: The easting and northing of the point, in meters.
+func (p TransverseMercator) Project(lonLat []float64) [2]float64 {
+	n := wgs84Flattening / (2 - wgs84Flattening)
+	rectifyingRadius := wgs84SemiMajorAxis / (1 + n) * (1 + n*n/4 + n*n*n*n/64)
+	alpha := [3]float64{
+		n/2 - 2*n*n/3 + 5*n*n*n/16,
+		13*n*n/48 - 3*n*n*n/5,
+		61 * n * n * n / 240,
+	}
+
+	latitude := lonLat[1] * math.Pi / 180
+	longitude := math.Remainder(lonLat[0]-p.CentralMeridian, 360) * math.Pi / 180
+
+	// Conformal latitude, through its tangent.
+	e := 2 * math.Sqrt(n) / (1 + n)
+	tau := math.Sinh(math.Atanh(math.Sin(latitude)) - e*math.Atanh(e*math.Sin(latitude)))
+	xi := math.Atan2(tau, math.Cos(longitude))
+	eta := math.Atanh(math.Sin(longitude) / math.Sqrt(1+tau*tau))
+
+	easting := eta
+	northing := xi
+	for j, a := range alpha {
+		k := 2 * float64(j+1)
+		easting += a * math.Cos(k*xi) * math.Sinh(k*eta)
+		northing += a * math.Sin(k*xi) * math.Cosh(k*eta)
+	}
+
+	return [2]float64{
+		p.FalseEasting + p.ScaleFactor*rectifyingRadius*easting,
+		p.FalseNorthing + p.ScaleFactor*rectifyingRadius*northing,
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package projected
+
+import (
+	"math"
+)
+
+const (
+	webMercatorRadius      = 6378137.0     // Radius of the sphere of EPSG:3857, in meters
+	webMercatorMaxLatitude = 85.0511287798 // Latitude where the projection is a square, in degrees
+	TileSize               = 256.0         // Size of a map tile, in pixels
+)
+
+// WebMercator is the EPSG:3857 projection used by web maps. Coordinates are in meters of the
+// projection, which are only true meters along the equator: at latitude φ, a meter on the ground
+// measures 1/cos(φ) meters of the projection. Coordinates are multiplied by Scale, so that they
+// can be expressed in pixels of a zoom level. Latitudes beyond the limit of web maps, about 85.05
+// degrees, are clamped to it.
+type WebMercator struct {
+	Scale float64 // Number of units of the output per meter of the projection
+}
+
+// NewWebMercator creates and returns a new projected geometry using EPSG:3857 meters, where
+// tolerances of the decimation algorithms are distances on the map in meters of the projection.
+//
+// Returns:
+//   - *Euclid: A new instance of the projected geometry system.
+func NewWebMercator() *Euclid {
+	return NewEuclid(WebMercator{Scale: 1})
+}
+
+// NewWebMercatorPixels creates and returns a new projected geometry using the pixels of web map
+// tiles of TileSize pixels at the given zoom level, where tolerances of the decimation algorithms
+// are distances on the screen in pixels.
+//
+// Parameters:
+//   - zoom (float64): The zoom level, where the whole world fits one tile at zoom 0.
+//
+// Returns:
+//   - *Euclid: A new instance of the projected geometry system.
+func NewWebMercatorPixels(zoom float64) *Euclid {
+	return NewEuclid(WebMercator{Scale: TileSize * math.Exp2(zoom) / (2 * math.Pi * webMercatorRadius)})
+}
+
+// Project maps WGS84 longitude and latitude to EPSG:3857 coordinates, multiplied by Scale.
+//
+// Parameters:
+//   - lonLat ([]float64): The longitude and latitude of the point, in degrees.
+//
+// Returns:
+//   - [2]float64: The easting and northing of the point.
+func (p WebMercator) Project(lonLat []float64) [2]float64 {
+	longitude := lonLat[0] * math.Pi / 180
+	latitude := math.Max(-webMercatorMaxLatitude, math.Min(webMercatorMaxLatitude, lonLat[1])) * math.Pi / 180
+
+	x := webMercatorRadius * longitude
+	y := webMercatorRadius * math.Log(math.Tan(math.Pi/4+latitude/2))
+	return [2]float64{p.Scale * x, p.Scale * y}
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package trajectory
+
+import (
+	"fmt"
+	"github.com/cenieto/decimate/pkg/decimate"
+	"github.com/cenieto/decimate/pkg/geomnd"
+	"github.com/cenieto/decimate/pkg/primitives"
+	"math"
+)
+
+// Euclid represents a Euclidean geometric system for trajectories, whose points are made of
+// spatial coordinates followed by a timestamp, such as x, y and t. Areas and distances ignore the
+// timestamp and are computed in space with geomnd.EuclidND, while the synchronized Euclidean
+// distance used by TDTR compares every point with the position interpolated at its timestamp.
+type Euclid struct {
+	space    *geomnd.EuclidND
+	Decimate *decimate.Decimate
+}
+
+// NewEuclid creates and returns a new instance of Euclid whose points have the given number of
+// spatial coordinates followed by a timestamp. It panics if the spatial dimension is lower than one.
+//
+// Parameters:
+//   - spatialDimension (int): The number of spatial coordinates of every point.
+//
+// Returns:
+//   - *Euclid: A new instance of the trajectory geometry system.
+func NewEuclid(spatialDimension int) *Euclid {
+	if spatialDimension < 1 {
+		panic(fmt.Sprintf("trajectory Euclid requires a spatial dimension of at least 1, but it is %d\n", spatialDimension))
+	}
+
+	e := &Euclid{space: geomnd.NewEuclid(spatialDimension)}
+	e.Decimate = decimate.NewDecimate(*e)
+	return e
+}
+
+// Dimension returns the dimension of the geometry system.
+//
+// Returns:
+//   - int: The number of spatial coordinates plus one for the timestamp.
+func (g Euclid) Dimension() int {
+	return g.space.Dimension() + 1
+}
+
+// spatial returns the spatial coordinates of a point, dropping its timestamp.
+//
+// Parameters:
+//   - coordinates ([]float64): The coordinates of the point, ending with its timestamp.
+//
+// Returns:
+//   - []float64: The spatial coordinates, sharing memory with the input.
+func (g Euclid) spatial(coordinates []float64) []float64 {
+	return coordinates[:g.space.Dimension()]
+}
+
+// checkDimension panics if any of the given coordinate lists does not have the dimension of the
+// geometry.
+//
+// Parameters:
+//   - operation (string): The name of the operation, used in the panic message.
+//   - coordinates (...[]float64): The coordinates of the operands.
+func (g Euclid) checkDimension(operation string, coordinates ...[]float64) {
+	for i, c := range coordinates {
+		if len(c) != g.Dimension() {
+			panic(fmt.Sprintf("%s in trajectory Euclid only accepts operands of dimension %d, but operand %d is of dimension %d\n", operation, g.Dimension(), i, len(c)))
+		}
+	}
+}
+
+// CrossProduct computes the exterior product of the spatial components of two vectors, see
+// geomnd.EuclidND.CrossProduct.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The first vector to be used in the product.
+//   - v2 (*primitives.Vector): The second vector to be used in the product.
+//
+// Returns:
+//   - *primitives.Vector: The components of the exterior product of the spatial components.
+func (g Euclid) CrossProduct(v1, v2 *primitives.Vector) *primitives.Vector {
+	a, b := v1.RawVector().Data, v2.RawVector().Data
+	g.checkDimension("CrossProduct", a, b)
+	return g.space.CrossProduct(primitives.NewVector(g.spatial(a)), primitives.NewVector(g.spatial(b)))
+}
+
+// CrossProductNorm computes the norm of the exterior product of the spatial components of two
+// vectors.
+//
+// Parameters:
+//   - v1 (*primitives.Vector): The first vector to be used in the product.
+//   - v2 (*primitives.Vector): The second vector to be used in the product.
+//
+// Returns:
+//   - float64: The area of the parallelogram formed by the spatial components.
+func (g Euclid) CrossProductNorm(v1, v2 *primitives.Vector) float64 {
+	a, b := v1.RawVector().Data, v2.RawVector().Data
+	g.checkDimension("CrossProductNorm", a, b)
+	return g.space.CrossProductNorm(primitives.NewVector(g.spatial(a)), primitives.NewVector(g.spatial(b)))
+}
+
+// DoubleAreaTriangle calculates the double of the area of the triangle formed in space by a point
+// and a line.
+//
+// Parameters:
+//   - point (*primitives.Point): The point used to form the triangle.
+//   - line (*primitives.Line): The line forming the base of the triangle.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g Euclid) DoubleAreaTriangle(point *primitives.Point, line *primitives.Line) float64 {
+	return g.DoubleAreaTriangleCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointLine computes the shortest distance in space from a point to a line, ignoring the
+// timestamps.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the line is being calculated.
+//   - line (*primitives.Line): The line to which the distance is being measured.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the line.
+func (g Euclid) DistancePointLine(point *primitives.Point, line *primitives.Line) float64 {
+	return g.DistancePointLineCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DistancePointSegment computes the shortest distance in space from a point to the segment
+// between the points of a line, ignoring the timestamps.
+//
+// Parameters:
+//   - point (*primitives.Point): The point whose distance to the segment is being calculated.
+//   - line (*primitives.Line): The line whose points define the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the segment.
+func (g Euclid) DistancePointSegment(point *primitives.Point, line *primitives.Line) float64 {
+	return g.DistancePointSegmentCoordinates(point.RawVector().Data, line.Point1.RawVector().Data, line.Point2.RawVector().Data)
+}
+
+// DoubleAreaTriangleCoordinates calculates the double of the area of the triangle formed in space
+// by a point and the line going from start to end.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point used to form the triangle.
+//   - start ([]float64): The coordinates of the first point of the line.
+//   - end ([]float64): The coordinates of the second point of the line.
+//
+// Returns:
+//   - float64: The double of the triangle's area.
+func (g Euclid) DoubleAreaTriangleCoordinates(point, start, end []float64) float64 {
+	g.checkDimension("DoubleAreaTriangle", point, start, end)
+	return g.space.DoubleAreaTriangleCoordinates(g.spatial(point), g.spatial(start), g.spatial(end))
+}
+
+// DistancePointLineCoordinates computes the shortest distance in space from a point to the line
+// going from start to end.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point whose distance to the line is being calculated.
+//   - start ([]float64): The coordinates of the first point of the line.
+//   - end ([]float64): The coordinates of the second point of the line.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the line.
+func (g Euclid) DistancePointLineCoordinates(point, start, end []float64) float64 {
+	g.checkDimension("DistancePointLine", point, start, end)
+	return g.space.DistancePointLineCoordinates(g.spatial(point), g.spatial(start), g.spatial(end))
+}
+
+// DistancePointSegmentCoordinates computes the shortest distance in space from a point to the
+// segment going from start to end.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point whose distance to the segment is being calculated.
+//   - start ([]float64): The coordinates of the first point of the segment.
+//   - end ([]float64): The coordinates of the second point of the segment.
+//
+// Returns:
+//   - float64: The shortest distance from the point to the segment.
+func (g Euclid) DistancePointSegmentCoordinates(point, start, end []float64) float64 {
+	g.checkDimension("DistancePointSegment", point, start, end)
+	return g.space.DistancePointSegmentCoordinates(g.spatial(point), g.spatial(start), g.spatial(end))
+}
+
+// Time returns the timestamp of a point, its last coordinate.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point.
+//
+// Returns:
+//   - float64: The timestamp of the point.
+func (g Euclid) Time(point []float64) float64 {
+	return point[len(point)-1]
+}
+
+// SynchronizedDistanceCoordinates computes the synchronized Euclidean distance from a point to the
+// segment going from start to end: the distance in space from the point to the position reached
+// at its timestamp when moving along the segment at constant speed. A segment whose end points
+// have the same timestamp is treated as its start point.
+//
+// Parameters:
+//   - point ([]float64): The coordinates of the point whose distance is being calculated.
+//   - start ([]float64): The coordinates of the first point of the segment.
+//   - end ([]float64): The coordinates of the second point of the segment.
+//
+// Returns:
+//   - float64: The distance from the point to its synchronized position.
+func (g Euclid) SynchronizedDistanceCoordinates(point, start, end []float64) float64 {
+	g.checkDimension("SynchronizedDistance", point, start, end)
+
+	ratio := 0.0
+	if duration := g.Time(end) - g.Time(start); duration != 0 {
+		ratio = (g.Time(point) - g.Time(start)) / duration
+	}
+
+	// The offset is accumulated coordinate by coordinate so the distance does not allocate.
+	distance := 0.0
+	for i := range g.space.Dimension() {
+		distance = math.Hypot(distance, point[i]-(start[i]+ratio*(end[i]-start[i])))
+	}
+	return distance
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 César Nieto Sánchez
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package trajectory
+
+import (
+	"github.com/cenieto/decimate/pkg/primitives"
+	"math"
+	"testing"
+)
+
+// TestTrajectoryInstantiation tests the correct instantiation of a trajectory object.
+func TestTrajectoryInstantiation(t *testing.T) {
+	for _, spatialDimension := range []int{1, 2, 3} {
+		geometry := NewEuclid(spatialDimension)
+
+		if geometry.Dimension() != spatialDimension+1 {
+			t.Errorf("geometry.Dimension() = %v; want %v", geometry.Dimension(), spatialDimension+1)
+		}
+		if geometry.Decimate.Geometry.Dimension() != spatialDimension+1 {
+			t.Errorf("geometry.Decimate.Geometry.Dimension() = %v; want %v", geometry.Decimate.Geometry.Dimension(), spatialDimension+1)
+		}
+	}
+}
+
+// TestTrajectoryInstantiationInvalid tests the instantiation of a trajectory object with an invalid
+// spatial dimension. Verifies that the constructor panics.
+func TestTrajectoryInstantiationInvalid(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("NewEuclid(0) did not panic")
+		}
+	}()
+
+	NewEuclid(0)
+}
+
+// TestSpatialDistances tests the areas and distances of the trajectory geometry.
+// Verifies that timestamps are ignored, so they match the ones of the spatial coordinates.
+func TestSpatialDistances(t *testing.T) {
+	geometry := NewEuclid(2)
+	point := primitives.NewPoint([]float64{1, 2, 100})
+	line := primitives.NewLine(primitives.NewPoint([]float64{0, 0, 0}), primitives.NewPoint([]float64{4, 0, 7}))
+
+	if result := geometry.DistancePointLine(point, line); result != 2 {
+		t.Errorf("DistancePointLine() = %v; want 2", result)
+	}
+	if result := geometry.DistancePointSegment(point, line); result != 2 {
+		t.Errorf("DistancePointSegment() = %v; want 2", result)
+	}
+	if result := geometry.DoubleAreaTriangle(point, line); result != 8 {
+		t.Errorf("DoubleAreaTriangle() = %v; want 8", result)
+	}
+
+	v1 := primitives.NewVector([]float64{1, 0, 5})
+	v2 := primitives.NewVector([]float64{0, 3, 9})
+	if result := geometry.CrossProductNorm(v1, v2); result != 3 {
+		t.Errorf("CrossProductNorm() = %v; want 3", result)
+	}
+}
+
+// TestSynchronizedDistance tests the synchronized Euclidean distance of the trajectory geometry.
+// Verifies that points are compared with the position interpolated at their timestamp.
+func TestSynchronizedDistance(t *testing.T) {
+	geometry := NewEuclid(2)
+	start, end := []float64{0, 0, 0}, []float64{10, 0, 10}
+
+	tests := []struct {
+		name     string
+		point    []float64
+		start    []float64
+		end      []float64
+		expected float64
+	}{
+		{"OnTime", []float64{5, 0, 5}, start, end, 0},
+		{"Late", []float64{2, 0, 5}, start, end, 3},
+		{"Aside", []float64{5, 4, 5}, start, end, 4},
+		{"Extrapolated", []float64{10, 0, 15}, start, end, 5},
+		{"SameTime", []float64{3, 4, 0}, start, []float64{10, 0, 0}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := geometry.SynchronizedDistanceCoordinates(tt.point, tt.start, tt.end); math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("SynchronizedDistanceCoordinates() = %v; want %v", result, tt.expected)
+			}
+		})
+	}
+	if result := geometry.Time([]float64{1, 2, 3}); result != 3 {
+		t.Errorf("Time() = %v; want 3", result)
+	}
+}
+
+// TestSynchronizedDistanceAllocations tests the synchronized Euclidean distance of the trajectory
+// geometry. Verifies that it does not allocate, since it runs in the inner loop of TDTR and of the
+// trajectory streams.
+func TestSynchronizedDistanceAllocations(t *testing.T) {
+	geometry := NewEuclid(3)
+	point, start, end := []float64{2, 1, 3, 5}, []float64{0, 0, 0, 0}, []float64{10, 2, 4, 10}
+
+	allocations := testing.AllocsPerRun(100, func() {
+		geometry.SynchronizedDistanceCoordinates(point, start, end)
+	})
+	if allocations != 0 {
+		t.Errorf("SynchronizedDistanceCoordinates() allocated %v times; want none", allocations)
+	}
+}